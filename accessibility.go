@@ -0,0 +1,169 @@
+package tfl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StepFreeLevel is how far a journey must be step free, from the street to the platform or into the vehicle
+type StepFreeLevel string
+
+// Step free levels supported by the journey planner
+const (
+	StepFreeToPlatform StepFreeLevel = "StepFreeToPlatform"
+	StepFreeToVehicle  StepFreeLevel = "StepFreeToVehicle"
+)
+
+const (
+	noEscalatorsPreference string = "NoEscalators"
+	accessibilityCategory  string = "Accessibility"
+	facilityCategory       string = "Facility"
+	accessViaLiftKey       string = "AccessViaLift"
+	stepFreeToVehicleKey   string = "StepFreeToVehicle"
+	escalatorsKey          string = "Escalators"
+	liftsKey               string = "Lifts"
+)
+
+// StepFreeJourney is a journey planned in step free mode and whether its stops meet the level asked for
+type StepFreeJourney struct {
+	Journey JourneyPlannerJourney
+	Passes  bool
+	Issues  []AccessibilityIssue
+}
+
+// AccessibilityIssue is a stop on a leg of a journey whose accessibility properties fail the level asked for
+type AccessibilityIssue struct {
+	LegIndex  int
+	StopPoint StopPointAPIResponse
+	Reason    string
+}
+
+// validate checks the level is one the journey planner supports, an empty level plans journeys with steps
+func (l StepFreeLevel) validate() error {
+	switch l {
+	case "", StepFreeToPlatform, StepFreeToVehicle:
+		return nil
+	}
+	return fmt.Errorf("invalid step free level %q, must be one of StepFreeToPlatform or StepFreeToVehicle", string(l))
+}
+
+// accessibilityPreferences returns the journey planner accessibilityPreference values for the query
+func (q JourneyPlannerQuery) accessibilityPreferences() []string {
+	preferences := []string{}
+	if q.StepFree != "" {
+		preferences = append(preferences, string(q.StepFree))
+	}
+	if q.AvoidEscalators {
+		preferences = append(preferences, noEscalatorsPreference)
+	}
+	return preferences
+}
+
+// property returns the value of the additional property in the category with the key
+func (s StopPointAPIResponse) property(category, key string) (string, bool) {
+	for _, property := range s.AdditionalProperties {
+		if strings.EqualFold(property.Category, category) && strings.EqualFold(property.Key, key) {
+			return property.Value, true
+		}
+	}
+	return "", false
+}
+
+// accessibilityIssues returns why the stop fails the query's accessibility requirements
+// Stops that do not publish a property are given the benefit of the doubt
+func (q JourneyPlannerQuery) accessibilityIssues(stop StopPointAPIResponse) []string {
+
+	reasons := []string{}
+	lift, hasLift := stop.property(accessibilityCategory, accessViaLiftKey)
+
+	// Reaching the vehicle step free needs the platform to be reached step free first
+	if q.StepFree != "" && hasLift && strings.EqualFold(lift, "No") {
+		reasons = append(reasons, fmt.Sprintf("%s is not step free from street to platform", stop.CommonName))
+	}
+	if q.StepFree == StepFreeToVehicle {
+		if vehicle, ok := stop.property(accessibilityCategory, stepFreeToVehicleKey); ok && strings.EqualFold(vehicle, "No") {
+			reasons = append(reasons, fmt.Sprintf("%s is not step free from platform to vehicle", stop.CommonName))
+		}
+	}
+
+	if q.AvoidEscalators && !(hasLift && strings.EqualFold(lift, "Yes")) {
+		escalators, _ := stop.property(facilityCategory, escalatorsKey)
+		lifts, _ := stop.property(facilityCategory, liftsKey)
+		if count, _ := strconv.Atoi(escalators); count > 0 {
+			if liftCount, _ := strconv.Atoi(lifts); liftCount == 0 {
+				reasons = append(reasons, fmt.Sprintf("%s can only be reached by escalator", stop.CommonName))
+			}
+		}
+	}
+
+	return reasons
+}
+
+// CheckStepFree cross checks the stops either end of each transport leg against the query's accessibility requirements
+// Stops are looked up by NaPTAN ID, walking and cycling legs are not checked
+func CheckStepFree(query JourneyPlannerQuery, journey JourneyPlannerJourney, stops map[string]StopPointAPIResponse) StepFreeJourney {
+
+	checked := StepFreeJourney{Journey: journey, Issues: []AccessibilityIssue{}}
+	for i, leg := range journey.Legs {
+		if !isStepFreeCheckedLeg(leg) {
+			continue
+		}
+		for _, point := range []StopPointAPIResponse{leg.DeparturePoint, leg.ArrivalPoint} {
+			stop, ok := stops[point.NaptanID]
+			if !ok {
+				continue
+			}
+			for _, reason := range query.accessibilityIssues(stop) {
+				checked.Issues = append(checked.Issues, AccessibilityIssue{LegIndex: i, StopPoint: stop, Reason: reason})
+			}
+		}
+	}
+	checked.Passes = len(checked.Issues) == 0
+	return checked
+}
+
+// GetStepFreeJourneys plans journeys with the query's accessibility preferences
+// and flags those with a stop whose published accessibility fails the level asked for
+// It queries the endpoints /Journey/JourneyResult/{from}/to/{to} and /StopPoint/{id} for each stop on the journeys
+func (c *TflClient) GetStepFreeJourneys(query JourneyPlannerQuery) (*[]StepFreeJourney, error) {
+
+	if query.StepFree == "" && !query.AvoidEscalators {
+		return nil, fmt.Errorf("step free journeys need a step free level or escalators to be avoided")
+	}
+
+	itinerary, err := c.GetJourneyPlannerItinerary(query)
+	if err != nil {
+		return nil, err
+	}
+
+	stops := map[string]StopPointAPIResponse{}
+	for _, journey := range itinerary.Journeys {
+		for _, leg := range journey.Legs {
+			if !isStepFreeCheckedLeg(leg) {
+				continue
+			}
+			for _, point := range []StopPointAPIResponse{leg.DeparturePoint, leg.ArrivalPoint} {
+				if _, ok := stops[point.NaptanID]; ok || point.NaptanID == "" {
+					continue
+				}
+				stop, err := c.GetStopPointForID(point.NaptanID)
+				if err != nil {
+					return nil, err
+				}
+				stops[point.NaptanID] = *stop
+			}
+		}
+	}
+
+	journeys := []StepFreeJourney{}
+	for _, journey := range itinerary.Journeys {
+		journeys = append(journeys, CheckStepFree(query, journey, stops))
+	}
+
+	return &journeys, nil
+}
+
+func isStepFreeCheckedLeg(leg Leg) bool {
+	return leg.Mode.ID != "walking" && !leg.IsCycle()
+}
@@ -0,0 +1,108 @@
+package tfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stepFreeStub(t *testing.T, wantPreference string) *TflClient {
+
+	stops := map[string]json.RawMessage{}
+	json.Unmarshal(getTestDataFileContents("step_free_stops.json"), &stops)
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/Journey/JourneyResults/") {
+			assert.Equal(t, wantPreference, r.URL.Query().Get("accessibilityPreference"))
+			w.Write(getTestDataFileContents("journey_step_free.json"))
+			return
+		}
+		stop, ok := stops[strings.TrimPrefix(r.URL.Path, "/StopPoint/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(fmt.Sprintf(`{"message": "The following stop point is not recognised: %s"}`, r.URL.Path)))
+			return
+		}
+		w.Write(stop)
+	}))
+	t.Cleanup(stub.Close)
+
+	stubClient, _ := New(WithBaseURL(stub.URL))
+	return stubClient
+}
+
+func TestTflClient_GetStepFreeJourneys(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          JourneyPlannerQuery
+		wantPreference string
+		wantPasses     []bool
+		wantReasons    []string
+	}{
+		{
+			name:           "Should flag journeys through stops without lifts",
+			query:          JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUWSM", StepFree: StepFreeToPlatform},
+			wantPreference: "StepFreeToPlatform",
+			wantPasses:     []bool{true, false},
+			wantReasons: []string{
+				"Oxford Circus Underground Station is not step free from street to platform",
+				"Oxford Circus Underground Station is not step free from street to platform",
+			},
+		},
+		{
+			name:           "Should flag stops step free to the platform but not the vehicle",
+			query:          JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUWSM", StepFree: StepFreeToVehicle},
+			wantPreference: "StepFreeToVehicle",
+			wantPasses:     []bool{false, false},
+			wantReasons: []string{
+				"Green Park Underground Station is not step free from platform to vehicle",
+				"Oxford Circus Underground Station is not step free from street to platform",
+				"Oxford Circus Underground Station is not step free from street to platform",
+			},
+		},
+		{
+			name:           "Should flag stops only reached by escalator",
+			query:          JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUWSM", StepFree: StepFreeToPlatform, AvoidEscalators: true},
+			wantPreference: "StepFreeToPlatform,NoEscalators",
+			wantPasses:     []bool{true, false},
+			wantReasons: []string{
+				"Oxford Circus Underground Station is not step free from street to platform",
+				"Oxford Circus Underground Station can only be reached by escalator",
+				"Oxford Circus Underground Station is not step free from street to platform",
+				"Oxford Circus Underground Station can only be reached by escalator",
+				"Westminster Underground Station can only be reached by escalator",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stepFreeStub(t, tt.wantPreference).GetStepFreeJourneys(tt.query)
+			assert.NoError(t, err)
+
+			passes := []bool{}
+			reasons := []string{}
+			for _, journey := range *got {
+				passes = append(passes, journey.Passes)
+				for _, issue := range journey.Issues {
+					reasons = append(reasons, issue.Reason)
+				}
+			}
+			assert.Equal(t, tt.wantPasses, passes)
+			assert.Equal(t, tt.wantReasons, reasons)
+		})
+	}
+}
+
+func TestTflClient_GetStepFreeJourneys_invalid(t *testing.T) {
+
+	_, err := client.GetStepFreeJourneys(JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUWSM"})
+	assert.EqualError(t, err, "step free journeys need a step free level or escalators to be avoided")
+
+	_, err = client.GetStepFreeJourneys(JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUWSM", StepFree: "StepFreeToStreet"})
+	assert.EqualError(t, err, `invalid step free level "StepFreeToStreet", must be one of StepFreeToPlatform or StepFreeToVehicle`)
+}
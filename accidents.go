@@ -0,0 +1,148 @@
+package tfl
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AccidentSeverity is the severity of an accident or of a casualty's injuries
+type AccidentSeverity string
+
+// Severities recorded by the accident statistics
+const (
+	AccidentSlight  AccidentSeverity = "Slight"
+	AccidentSerious AccidentSeverity = "Serious"
+	AccidentFatal   AccidentSeverity = "Fatal"
+)
+
+// AccidentFilter narrows the accidents returned for a year
+// Empty fields match every accident, From is inclusive and To is exclusive
+type AccidentFilter struct {
+	Boroughs   []string
+	Severities []AccidentSeverity
+	From       time.Time
+	To         time.Time
+}
+
+// Matches reports whether the accident passes every field of the filter
+func (f AccidentFilter) Matches(accident AccidentDetail) (bool, error) {
+
+	if len(f.Boroughs) > 0 && !containsFold(f.Boroughs, accident.Borough) {
+		return false, nil
+	}
+
+	if len(f.Severities) > 0 {
+		matched := false
+		for _, severity := range f.Severities {
+			if strings.EqualFold(string(severity), string(accident.Severity)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	if f.From.IsZero() && f.To.IsZero() {
+		return true, nil
+	}
+	date, err := accident.Time()
+	if err != nil {
+		return false, err
+	}
+	if !f.From.IsZero() && date.Before(f.From) {
+		return false, nil
+	}
+	if !f.To.IsZero() && !date.Before(f.To) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Time returns the parsed date and time of the accident
+func (a AccidentDetail) Time() (time.Time, error) {
+	return parseTflTime(a.Date)
+}
+
+// AccidentStream decodes the accidents of a year one at a time as they are read from the response
+type AccidentStream struct {
+	*arrayDecoder
+	filter AccidentFilter
+}
+
+// Next returns the next accident that matches the filter
+// It returns io.EOF once every accident has been read
+func (s *AccidentStream) Next() (*AccidentDetail, error) {
+
+	for {
+		accident := AccidentDetail{}
+		if err := s.next(&accident); err != nil {
+			return nil, err
+		}
+		matched, err := s.filter.Matches(accident)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return &accident, nil
+		}
+	}
+}
+
+// StreamAccidentStats opens the accidents for a year without buffering the response
+// The stream must be closed once finished with
+// It queries the endpoint /AccidentStats/{year}
+func (c *TflClient) StreamAccidentStats(year int, filter AccidentFilter) (*AccidentStream, error) {
+
+	pathParams := []string{accidentStatsPath, strconv.Itoa(year)}
+	url := c.buildURL(pathParams)
+
+	body, err := c.getStream(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccidentStream{
+		arrayDecoder: newArrayDecoder(body),
+		filter:       filter,
+	}, nil
+}
+
+// GetAccidentStats retrieves the accidents for a year that match the filter
+// Only matching accidents are held in memory
+// It queries the endpoint /AccidentStats/{year}
+func (c *TflClient) GetAccidentStats(year int, filter AccidentFilter) (*[]AccidentDetail, error) {
+
+	stream, err := c.StreamAccidentStats(year, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	accidents := []AccidentDetail{}
+	for {
+		accident, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		accidents = append(accidents, *accident)
+	}
+
+	return &accidents, nil
+}
+
+// containsFold reports whether the value is in the list, ignoring case
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
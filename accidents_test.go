@@ -0,0 +1,94 @@
+package tfl
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetAccidentStats(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter AccidentFilter
+		want   []int
+	}{
+		{
+			name: "Should return every accident for an empty filter",
+			want: []int{345906, 345907, 345908, 345909},
+		},
+		{
+			name:   "Should filter by borough ignoring case",
+			filter: AccidentFilter{Boroughs: []string{"camden"}},
+			want:   []int{345907, 345909},
+		},
+		{
+			name:   "Should filter by severity",
+			filter: AccidentFilter{Severities: []AccidentSeverity{AccidentSerious, AccidentFatal}},
+			want:   []int{345907, 345908},
+		},
+		{
+			name: "Should filter by date with an exclusive end",
+			filter: AccidentFilter{
+				From: time.Date(2019, 3, 2, 8, 15, 0, 0, time.UTC),
+				To:   time.Date(2019, 11, 5, 12, 5, 0, 0, time.UTC),
+			},
+			want: []int{345907, 345908},
+		},
+		{
+			name: "Should combine filters",
+			filter: AccidentFilter{
+				Boroughs: []string{"Camden"},
+				From:     time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: []int{345909},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetAccidentStats(2019, tt.filter)
+			assert.NoError(t, err)
+			ids := []int{}
+			for _, accident := range *got {
+				ids = append(ids, accident.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestTflClient_StreamAccidentStats(t *testing.T) {
+
+	stream, err := client.StreamAccidentStats(2019, AccidentFilter{Severities: []AccidentSeverity{AccidentFatal}})
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	accident, err := stream.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "Westminster", accident.Borough)
+	assert.Equal(t, []AccidentCasualty{{Age: 19, Class: "Driver", Severity: AccidentFatal, Mode: "PoweredTwoWheeler", AgeBand: "Adult"}}, accident.Casualties)
+	assert.Equal(t, []AccidentVehicle{{Type: "Motorcycle_125cc_Under"}, {Type: "BusOrCoach"}}, accident.Vehicles)
+	date, err := accident.Time()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 6, 21, 23, 40, 0, 0, time.UTC), date)
+
+	_, err = stream.Next()
+	assert.Equal(t, io.EOF, err)
+	_, err = stream.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestTflClient_StreamAccidentStats_unexpectedResponse(t *testing.T) {
+
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"message": "not an array"}`))
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	_, err := stubClient.GetAccidentStats(2019, AccidentFilter{})
+	assert.EqualError(t, err, "expected [ in response but found {")
+}
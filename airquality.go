@@ -0,0 +1,107 @@
+package tfl
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// AirQualityBand is the level of air pollution forecast
+type AirQualityBand string
+
+// The bands used by the air quality forecast
+const (
+	AirQualityLow      AirQualityBand = "Low"
+	AirQualityModerate AirQualityBand = "Moderate"
+	AirQualityHigh     AirQualityBand = "High"
+	AirQualityVeryHigh AirQualityBand = "Very High"
+)
+
+// Pollutant is a pollutant the air quality forecast gives a band for
+type Pollutant string
+
+// The pollutants the air quality forecast gives a band for
+const (
+	NO2  Pollutant = "NO2"
+	O3   Pollutant = "O3"
+	PM10 Pollutant = "PM10"
+	PM25 Pollutant = "PM2.5"
+	SO2  Pollutant = "SO2"
+)
+
+// The forecast types of an AirQualityForecast
+const (
+	currentForecastType string = "Current"
+	futureForecastType  string = "Future"
+)
+
+var (
+	lineBreakTag = regexp.MustCompile(`(?i)<br\s*/?>|</p>`)
+	htmlTag      = regexp.MustCompile(`<[^>]*>`)
+	spaces       = regexp.MustCompile(`[ \t]+`)
+	blankLines   = regexp.MustCompile(`\s*\n\s*`)
+)
+
+// Bands returns the band forecast for each pollutant
+func (f AirQualityForecast) Bands() map[Pollutant]AirQualityBand {
+	return map[Pollutant]AirQualityBand{
+		NO2:  f.NO2Band,
+		O3:   f.O3Band,
+		PM10: f.PM10Band,
+		PM25: f.PM25Band,
+		SO2:  f.SO2Band,
+	}
+}
+
+// Current returns the forecast for the current period
+func (a AirQuality) Current() (AirQualityForecast, bool) {
+	return a.forecastOfType(currentForecastType)
+}
+
+// Forecast returns the forecast for the next period
+func (a AirQuality) Forecast() (AirQualityForecast, bool) {
+	return a.forecastOfType(futureForecastType)
+}
+
+func (a AirQuality) forecastOfType(forecastType string) (AirQualityForecast, bool) {
+	for _, forecast := range a.CurrentForecast {
+		if strings.EqualFold(forecast.ForecastType, forecastType) {
+			return forecast, true
+		}
+	}
+	return AirQualityForecast{}, false
+}
+
+// stripHTML converts the HTML TfL embeds in forecasts, which may itself be escaped, to plain text
+// Line breaks and paragraphs become new lines
+func stripHTML(text string) string {
+	text = html.UnescapeString(text)
+	text = lineBreakTag.ReplaceAllString(text, "\n")
+	text = htmlTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	text = spaces.ReplaceAllString(text, " ")
+	text = blankLines.ReplaceAllString(text, "\n")
+	return strings.TrimSpace(text)
+}
+
+// GetAirQuality retrieves the current and next air quality forecasts for London
+// The forecast text is stripped to plain text, with the original kept in ForecastHTML
+// It queries the endpoint /AirQuality
+func (c *TflClient) GetAirQuality() (*AirQuality, error) {
+
+	pathParams := []string{airQualityPath}
+	url := c.buildURL(pathParams)
+
+	resp := AirQuality{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	for i := range resp.CurrentForecast {
+		forecast := &resp.CurrentForecast[i]
+		forecast.ForecastHTML = forecast.ForecastText
+		forecast.ForecastText = stripHTML(forecast.ForecastText)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,37 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetAirQuality(t *testing.T) {
+
+	raw := AirQuality{}
+	json.Unmarshal(getTestDataFileContents("air_quality.json"), &raw)
+
+	got, err := client.GetAirQuality()
+	assert.NoError(t, err)
+	assert.Len(t, got.CurrentForecast, 2)
+
+	current, ok := got.Current()
+	assert.True(t, ok)
+	assert.Equal(t, AirQualityLow, current.ForecastBand)
+	assert.Equal(t, raw.CurrentForecast[0].ForecastText, current.ForecastHTML)
+	assert.Equal(t, "Breezy conditions are expected to continue.\n"+
+		"Air pollution is expected to remain 'Low' throughout the forecast period for the following pollutants:\n"+
+		"Nitrogen Dioxide\nOzone\nPM10 Particulates\nPM2.5 Particulates\nSulphur Dioxide", current.ForecastText)
+
+	forecast, ok := got.Forecast()
+	assert.True(t, ok)
+	assert.Equal(t, map[Pollutant]AirQualityBand{
+		NO2:  AirQualityModerate,
+		O3:   AirQualityLow,
+		PM10: AirQualityLow,
+		PM25: AirQualityModerate,
+		SO2:  AirQualityLow,
+	}, forecast.Bands())
+	assert.Equal(t, "Lighter winds will allow pollution to build.\nLevels may reach 'Moderate' near busy roads.", forecast.ForecastText)
+}
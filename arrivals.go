@@ -0,0 +1,167 @@
+package tfl
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// arrivalJumpThreshold is how far a vehicle's expected arrival can move between snapshots before it is smoothed
+const arrivalJumpThreshold = time.Minute
+
+// ArrivalsBoard is a snapshot of the predicted arrivals at a stop point grouped by platform
+type ArrivalsBoard struct {
+	StopPointID string
+	Timestamp   time.Time
+	Platforms   []PlatformArrivals
+}
+
+// PlatformArrivals are the predicted arrivals at a platform, soonest first
+type PlatformArrivals struct {
+	PlatformName string
+	Predictions  []Prediction
+}
+
+// arrivalsBoardBuilder builds successive ArrivalsBoards, remembering each vehicle's expected arrival for smoothing
+type arrivalsBoardBuilder struct {
+	stopPointID string
+	expected    map[string]time.Time
+}
+
+// GetArrivals retrieves the predicted arrivals at a stop point
+// It queries the endpoint /StopPoint/{id}/Arrivals
+func (c *TflClient) GetArrivals(stopPointID string) (*[]Prediction, error) {
+	return c.getArrivals(context.Background(), stopPointID)
+}
+
+func (c *TflClient) getArrivals(ctx context.Context, stopPointID string) (*[]Prediction, error) {
+
+	pathParams := []string{stopPointPath, stopPointID, arrivalsPath}
+	url := c.buildURL(pathParams)
+
+	resp := []Prediction{}
+	if err := c.getJSONContext(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// StreamArrivalsBoard polls the arrivals at a stop point on the interval and sends a board for each poll
+// Vehicles that have left are dropped and expected arrivals that jump by more than a minute are smoothed
+// The latest failed poll is kept on the second channel until read, it need not be read for boards to keep coming
+// Both channels are closed once the context is cancelled
+func (c *TflClient) StreamArrivalsBoard(ctx context.Context, stopPointID string, interval time.Duration) (<-chan ArrivalsBoard, <-chan error, error) {
+
+	if interval <= 0 {
+		return nil, nil, errors.New("arrivals poll interval must be positive")
+	}
+
+	boards := make(chan ArrivalsBoard)
+	errs := make(chan error, 1)
+	builder := newArrivalsBoardBuilder(stopPointID)
+
+	go func() {
+		defer close(boards)
+		defer close(errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			board, err := c.pollArrivalsBoard(ctx, builder)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				sendLatestError(errs, err)
+			} else {
+				select {
+				case boards <- *board:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return boards, errs, nil
+}
+
+func (c *TflClient) pollArrivalsBoard(ctx context.Context, builder *arrivalsBoardBuilder) (*ArrivalsBoard, error) {
+	predictions, err := c.getArrivals(ctx, builder.stopPointID)
+	if err != nil {
+		return nil, err
+	}
+	return builder.build(*predictions)
+}
+
+func newArrivalsBoardBuilder(stopPointID string) *arrivalsBoardBuilder {
+	return &arrivalsBoardBuilder{
+		stopPointID: stopPointID,
+		expected:    map[string]time.Time{},
+	}
+}
+
+// build turns predictions into a board, timed from the latest prediction timestamp
+func (b *arrivalsBoardBuilder) build(predictions []Prediction) (*ArrivalsBoard, error) {
+
+	board := &ArrivalsBoard{StopPointID: b.stopPointID}
+	for _, prediction := range predictions {
+		timestamp, err := parseTflTime(prediction.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		if timestamp.After(board.Timestamp) {
+			board.Timestamp = timestamp
+		}
+	}
+
+	expected := map[string]time.Time{}
+	platforms := map[string][]Prediction{}
+	for _, prediction := range predictions {
+		arrival, err := parseTflTime(prediction.ExpectedArrival)
+		if err != nil {
+			return nil, err
+		}
+
+		if previous, ok := b.expected[prediction.ID]; ok {
+			if jump := arrival.Sub(previous); jump > arrivalJumpThreshold || jump < -arrivalJumpThreshold {
+				arrival = previous.Add(jump / 2)
+			}
+		}
+		if arrival.Before(board.Timestamp) {
+			continue
+		}
+
+		expected[prediction.ID] = arrival
+		prediction.ExpectedArrival = arrival.Format(time.RFC3339)
+		prediction.TimeToStation = int(arrival.Sub(board.Timestamp).Seconds())
+		platforms[prediction.PlatformName] = append(platforms[prediction.PlatformName], prediction)
+	}
+	b.expected = expected
+
+	names := []string{}
+	for name := range platforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	board.Platforms = []PlatformArrivals{}
+	for _, name := range names {
+		platform := platforms[name]
+		sort.SliceStable(platform, func(i, j int) bool {
+			return platform[i].TimeToStation < platform[j].TimeToStation
+		})
+		board.Platforms = append(board.Platforms, PlatformArrivals{PlatformName: name, Predictions: platform})
+	}
+
+	return board, nil
+}
@@ -0,0 +1,168 @@
+package tfl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetArrivals(t *testing.T) {
+
+	expected := []Prediction{}
+	json.Unmarshal(getTestDataFileContents("arrivals.json"), &expected)
+
+	got, err := client.GetArrivals("940GZZLUOXC")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestTflClient_StreamArrivalsBoard(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	boards, errs, err := client.StreamArrivalsBoard(ctx, "940GZZLUOXC", time.Millisecond)
+	assert.NoError(t, err)
+
+	board := <-boards
+	assert.Equal(t, "940GZZLUOXC", board.StopPointID)
+	assert.Len(t, board.Platforms, 2)
+
+	northbound := board.Platforms[0]
+	assert.Equal(t, "Northbound - Platform 5", northbound.PlatformName)
+	assert.Equal(t, "204", northbound.Predictions[0].VehicleID)
+	assert.Equal(t, "203", northbound.Predictions[1].VehicleID)
+
+	southbound := board.Platforms[1]
+	assert.Len(t, southbound.Predictions, 1, "vehicle that has left should be dropped")
+	assert.Equal(t, "237", southbound.Predictions[0].VehicleID)
+
+	cancel()
+	for range boards {
+	}
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestTflClient_StreamArrivalsBoard_cancelDuringPoll(t *testing.T) {
+
+	requested := make(chan struct{}, 1)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	boards, errs, err := stubClient.StreamArrivalsBoard(ctx, "940GZZLUOXC", time.Minute)
+	assert.NoError(t, err)
+	<-requested
+	cancel()
+
+	select {
+	case _, ok := <-boards:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop while a poll was in flight")
+	}
+	for range errs {
+	}
+}
+
+func TestTflClient_StreamArrivalsBoard_failedPollUnread(t *testing.T) {
+
+	var mu sync.Mutex
+	requests := 0
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		failed := requests <= 2
+		mu.Unlock()
+		if failed {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"Internal Server Error"}`))
+			return
+		}
+		w.Write(getTestDataFileContents("arrivals.json"))
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	boards, errs, err := stubClient.StreamArrivalsBoard(ctx, "940GZZLUOXC", time.Millisecond)
+	assert.NoError(t, err)
+
+	// Only boards are read, the failed polls must not hold them up
+	select {
+	case board := <-boards:
+		assert.Equal(t, "940GZZLUOXC", board.StopPointID)
+	case <-time.After(time.Second):
+		t.Fatal("boards stopped after a failed poll that was not read")
+	}
+	assert.EqualError(t, <-errs, "Internal Server Error", "latest error should be kept")
+
+	cancel()
+	for range boards {
+	}
+}
+
+func TestTflClient_StreamArrivalsBoard_invalidInterval(t *testing.T) {
+	boards, errs, err := client.StreamArrivalsBoard(context.Background(), "940GZZLUOXC", 0)
+	assert.Nil(t, boards)
+	assert.Nil(t, errs)
+	assert.EqualError(t, err, "arrivals poll interval must be positive")
+}
+
+func TestArrivalsBoardBuilder_build(t *testing.T) {
+
+	prediction := func(expectedArrival string) Prediction {
+		return Prediction{
+			ID:              "1",
+			PlatformName:    "Platform 1",
+			Timestamp:       "2020-08-22T16:10:00Z",
+			ExpectedArrival: expectedArrival,
+		}
+	}
+
+	builder := newArrivalsBoardBuilder("940GZZLUOXC")
+	tests := []struct {
+		name            string
+		expectedArrival string
+		want            string
+		wantTime        int
+	}{
+		{
+			name:            "Should take the first prediction as is",
+			expectedArrival: "2020-08-22T16:15:00Z",
+			want:            "2020-08-22T16:15:00Z",
+			wantTime:        300,
+		},
+		{
+			name:            "Should take small changes as is",
+			expectedArrival: "2020-08-22T16:15:30Z",
+			want:            "2020-08-22T16:15:30Z",
+			wantTime:        330,
+		},
+		{
+			name:            "Should smooth predictions that jump",
+			expectedArrival: "2020-08-22T16:19:30Z",
+			want:            "2020-08-22T16:17:30Z",
+			wantTime:        450,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, err := builder.build([]Prediction{prediction(tt.expectedArrival)})
+			assert.NoError(t, err)
+			got := board.Platforms[0].Predictions[0]
+			assert.Equal(t, tt.want, got.ExpectedArrival)
+			assert.Equal(t, tt.wantTime, got.TimeToStation)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package tfl
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimeIs sets whether the query's date and time is when the journey departs or arrives
+type TimeIs string
+
+// Values of timeIs supported by the journey planner
+const (
+	TimeIsDeparting TimeIs = "Departing"
+	TimeIsArriving  TimeIs = "Arriving"
+)
+
+const (
+	journeyDateLayout string = "20060102"
+	journeyTimeLayout string = "1504"
+
+	// defaultArriveByAttempts is how many times the journey planner is asked before giving up
+	defaultArriveByAttempts int = 3
+)
+
+// ArriveByQuery asks for the latest departing journeys that arrive before a deadline
+// Buffer is taken off the deadline as a safety margin and MaxAttempts bounds how often the planner is re-queried
+type ArriveByQuery struct {
+	Query       JourneyPlannerQuery
+	Deadline    time.Time
+	Buffer      time.Duration
+	MaxAttempts int
+}
+
+// ArriveByJourney is a journey that arrives within the buffered deadline
+// Departure and Arrival are in Europe/London and Slack is the time left between arriving and the deadline, buffer included
+type ArriveByJourney struct {
+	Journey   JourneyPlannerJourney
+	Departure time.Time
+	Arrival   time.Time
+	Slack     time.Duration
+}
+
+// validate checks the value is one the journey planner supports, an empty value is left to the API default of departing
+func (t TimeIs) validate() error {
+	switch t {
+	case "", TimeIsDeparting, TimeIsArriving:
+		return nil
+	}
+	return fmt.Errorf("invalid timeIs %q, must be one of Departing or Arriving", string(t))
+}
+
+// GetLatestDepartures plans journeys arriving by the deadline less the buffer, latest departing first
+// TfL may return journeys that arrive after the time asked for, these are dropped and
+// if none are left the planner is asked again for an arrival before the earliest overshoot
+// It queries the endpoint /Journey/JourneyResult/{from}/to/{to}
+func (c *TflClient) GetLatestDepartures(query ArriveByQuery) (*[]ArriveByJourney, error) {
+
+	if query.Deadline.IsZero() {
+		return nil, errors.New("arrive by queries need a deadline")
+	}
+	if query.Buffer < 0 {
+		return nil, errors.New("arrive by buffer must not be negative")
+	}
+	attempts := query.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultArriveByAttempts
+	}
+
+	// Journey times are London wall clock times, so the planner is asked in London time
+	london, err := loadLondon()
+	if err != nil {
+		return nil, err
+	}
+	deadline := query.Deadline.In(london)
+	latestArrival := deadline.Add(-query.Buffer)
+
+	arriveBy := latestArrival
+	for attempt := 0; attempt < attempts; attempt++ {
+		planned := query.Query
+		planned.TimeIs = TimeIsArriving
+		planned.Date = arriveBy.Format(journeyDateLayout)
+		planned.Time = arriveBy.Format(journeyTimeLayout)
+
+		itinerary, err := c.GetJourneyPlannerItinerary(planned)
+		if err != nil {
+			return nil, err
+		}
+
+		journeys, overshoot, err := journeysArrivingBy(itinerary.Journeys, latestArrival, deadline, london)
+		if err != nil {
+			return nil, err
+		}
+		if len(journeys) > 0 {
+			return &journeys, nil
+		}
+		if overshoot <= 0 {
+			break
+		}
+		// The planner works in whole minutes, so step back by the overshoot rounded up
+		step := overshoot.Truncate(time.Minute)
+		if step < overshoot {
+			step += time.Minute
+		}
+		arriveBy = arriveBy.Add(-step)
+	}
+
+	return nil, fmt.Errorf("no journeys arrive by %s after %d attempts", latestArrival.Format(tflTimeLayout), attempts)
+}
+
+// journeysArrivingBy returns the journeys arriving by latestArrival, latest departing first
+// along with how far the earliest arriving of the remaining journeys overshot
+// The journeys' times are parsed in loc, the location the planner was asked in
+func journeysArrivingBy(planned []JourneyPlannerJourney, latestArrival, deadline time.Time, loc *time.Location) ([]ArriveByJourney, time.Duration, error) {
+
+	journeys := []ArriveByJourney{}
+	var overshoot time.Duration
+	for _, journey := range planned {
+		departure, err := parseTflTimeIn(journey.StartDateTime, loc)
+		if err != nil {
+			return nil, 0, err
+		}
+		arrival, err := parseTflTimeIn(journey.ArrivalDateTime, loc)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if late := arrival.Sub(latestArrival); late > 0 {
+			if overshoot == 0 || late < overshoot {
+				overshoot = late
+			}
+			continue
+		}
+		journeys = append(journeys, ArriveByJourney{
+			Journey:   journey,
+			Departure: departure,
+			Arrival:   arrival,
+			Slack:     deadline.Sub(arrival),
+		})
+	}
+
+	sort.SliceStable(journeys, func(i, j int) bool {
+		return journeys[i].Departure.After(journeys[j].Departure)
+	})
+	return journeys, overshoot, nil
+}
@@ -0,0 +1,125 @@
+package tfl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func londonTime(t *testing.T) *time.Location {
+	london, err := loadLondon()
+	assert.NoError(t, err)
+	return london
+}
+
+func arriveByJourney(departure, arrival string) JourneyPlannerJourney {
+	return JourneyPlannerJourney{StartDateTime: "2020-08-24T" + departure + ":00", ArrivalDateTime: "2020-08-24T" + arrival + ":00"}
+}
+
+// arriveByStub answers journey planner requests from the journeys for each requested time
+func arriveByStub(t *testing.T, responses map[string][]JourneyPlannerJourney) (*TflClient, *[]string) {
+
+	requested := []string{}
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Arriving", r.URL.Query().Get("timeIs"))
+		assert.Equal(t, "20200824", r.URL.Query().Get("date"))
+		requested = append(requested, r.URL.Query().Get("time"))
+		resp, _ := json.Marshal(JourneyPlannerItineraryResult{Journeys: responses[r.URL.Query().Get("time")]})
+		w.Write(resp)
+	}))
+	t.Cleanup(stub.Close)
+
+	stubClient, _ := New(WithBaseURL(stub.URL))
+	return stubClient, &requested
+}
+
+func TestTflClient_GetLatestDepartures(t *testing.T) {
+
+	stubClient, requested := arriveByStub(t, map[string][]JourneyPlannerJourney{
+		"0855": {arriveByJourney("08:30", "08:58"), arriveByJourney("08:35", "09:02")},
+		"0852": {arriveByJourney("08:20", "08:50"), arriveByJourney("08:30", "08:57"), arriveByJourney("08:25", "08:52")},
+	})
+
+	got, err := stubClient.GetLatestDepartures(ArriveByQuery{
+		Query:    JourneyPlannerQuery{From: "1000173", To: "940GZZLUCYF"},
+		Deadline: time.Date(2020, 8, 24, 9, 0, 0, 0, londonTime(t)),
+		Buffer:   5 * time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0855", "0852"}, *requested)
+
+	assert.Len(t, *got, 2)
+	assert.Equal(t, time.Date(2020, 8, 24, 8, 25, 0, 0, londonTime(t)), (*got)[0].Departure)
+	assert.Equal(t, 8*time.Minute, (*got)[0].Slack)
+	assert.Equal(t, time.Date(2020, 8, 24, 8, 20, 0, 0, londonTime(t)), (*got)[1].Departure)
+	assert.Equal(t, 10*time.Minute, (*got)[1].Slack)
+}
+
+func TestTflClient_GetLatestDepartures_utcDeadline(t *testing.T) {
+
+	stubClient, requested := arriveByStub(t, map[string][]JourneyPlannerJourney{
+		"0900": {arriveByJourney("08:30", "08:58")},
+	})
+
+	// 08:00 UTC is 09:00 in London during British Summer Time
+	deadline := time.Date(2020, 8, 24, 8, 0, 0, 0, time.UTC)
+	got, err := stubClient.GetLatestDepartures(ArriveByQuery{
+		Query:    JourneyPlannerQuery{From: "1000173", To: "940GZZLUCYF"},
+		Deadline: deadline,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0900"}, *requested)
+	arrival := (*got)[0].Arrival
+	assert.True(t, arrival.Equal(time.Date(2020, 8, 24, 7, 58, 0, 0, time.UTC)), "08:58 in London is 07:58 UTC, got %s", arrival)
+	assert.Equal(t, 2*time.Minute, (*got)[0].Slack)
+	assert.Equal(t, deadline.Sub(arrival), (*got)[0].Slack)
+}
+
+func TestTflClient_GetLatestDepartures_noJourneys(t *testing.T) {
+
+	stubClient, requested := arriveByStub(t, map[string][]JourneyPlannerJourney{
+		"0900": {arriveByJourney("08:30", "09:01")},
+		"0859": {arriveByJourney("08:30", "09:01")},
+	})
+
+	_, err := stubClient.GetLatestDepartures(ArriveByQuery{
+		Query:       JourneyPlannerQuery{From: "1000173", To: "940GZZLUCYF"},
+		Deadline:    time.Date(2020, 8, 24, 9, 0, 0, 0, londonTime(t)),
+		MaxAttempts: 2,
+	})
+	assert.EqualError(t, err, "no journeys arrive by 2020-08-24T09:00:00 after 2 attempts")
+	assert.Equal(t, []string{"0900", "0859"}, *requested)
+}
+
+func TestTflClient_GetLatestDepartures_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   ArriveByQuery
+		wantErr string
+	}{
+		{
+			name:    "Should require a deadline",
+			query:   ArriveByQuery{Query: JourneyPlannerQuery{From: "1000173", To: "940GZZLUCYF"}},
+			wantErr: "arrive by queries need a deadline",
+		},
+		{
+			name:    "Should reject a negative buffer",
+			query:   ArriveByQuery{Deadline: time.Now(), Buffer: -time.Minute},
+			wantErr: "arrive by buffer must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetLatestDepartures(tt.query)
+			assert.Nil(t, got)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := client.GetJourneyPlannerItinerary(JourneyPlannerQuery{From: "1000173", To: "940GZZLUCYF", TimeIs: "Leaving"})
+	assert.EqualError(t, err, `invalid timeIs "Leaving", must be one of Departing or Arriving`)
+}
@@ -0,0 +1,106 @@
+package tfl
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// defaultBatchParallelism is how many journeys are planned at once when not set
+const defaultBatchParallelism int = 4
+
+// BatchOptions configures how a batch of journeys is planned
+// Progress is called after each query finishes, one call at a time
+type BatchOptions struct {
+	Parallelism int
+	Progress    func(BatchProgress)
+}
+
+// BatchProgress counts the queries of a batch that have finished
+type BatchProgress struct {
+	Completed int
+	Failed    int
+	Total     int
+}
+
+// BatchResult is the itinerary or error for a query of a batch
+type BatchResult struct {
+	Query     JourneyPlannerQuery
+	Itinerary *JourneyPlannerItineraryResult
+	Err       error
+}
+
+// PlanJourneys plans every query with at most Parallelism requests in flight, sharing the client's rate limit
+// Results are in the same order as the queries and a failed query does not stop the others
+// If the context is cancelled the results so far are returned along with the context's error,
+// queries that did not finish have the context's error as theirs
+func (c *TflClient) PlanJourneys(ctx context.Context, queries []JourneyPlannerQuery, opts BatchOptions) ([]BatchResult, error) {
+
+	if opts.Parallelism < 0 {
+		return nil, errors.New("batch parallelism must not be negative")
+	}
+	parallelism := opts.Parallelism
+	if parallelism == 0 {
+		parallelism = defaultBatchParallelism
+	}
+
+	results := make([]BatchResult, len(queries))
+	for i, query := range queries {
+		results[i] = BatchResult{Query: query}
+	}
+
+	var mu sync.Mutex
+	progress := BatchProgress{Total: len(queries)}
+	finished := make([]bool, len(queries))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < parallelism; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				itinerary, err := c.getJourneyPlannerItinerary(ctx, queries[i])
+				if err != nil && ctx.Err() != nil {
+					// Cancelled requests are reported with the context's error below
+					continue
+				}
+
+				mu.Lock()
+				results[i].Itinerary = itinerary
+				results[i].Err = err
+				finished[i] = true
+				if err != nil {
+					progress.Failed++
+				} else {
+					progress.Completed++
+				}
+				if opts.Progress != nil {
+					opts.Progress(progress)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for i := range queries {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if !finished[i] {
+				results[i].Err = err
+			}
+		}
+		return results, err
+	}
+	return results, nil
+}
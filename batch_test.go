@@ -0,0 +1,143 @@
+package tfl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// batchStub plans a journey lasting as many minutes as the origin's number, or fails for INVALID origins
+func batchStub(t *testing.T, delay time.Duration) (*httptest.Server, *int) {
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		from := strings.Split(strings.TrimPrefix(r.URL.Path, "/"+journeyResultsPath+"/"), "/")[0]
+		if from == "INVALID" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "No journey found"}`))
+			return
+		}
+		w.Write([]byte(fmt.Sprintf(`{"journeys": [{"duration": %s}]}`, from)))
+	}))
+	t.Cleanup(stub.Close)
+	return stub, &maxInFlight
+}
+
+func batchQueries(origins ...string) []JourneyPlannerQuery {
+	queries := []JourneyPlannerQuery{}
+	for _, origin := range origins {
+		queries = append(queries, JourneyPlannerQuery{From: Location(origin), To: "1000173", Date: "20200824", Time: "0830"})
+	}
+	return queries
+}
+
+func TestTflClient_PlanJourneys(t *testing.T) {
+
+	stub, maxInFlight := batchStub(t, 10*time.Millisecond)
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	progress := []BatchProgress{}
+	queries := batchQueries("1", "2", "INVALID", "4", "5", "6", "7", "8")
+	got, err := stubClient.PlanJourneys(context.Background(), queries, BatchOptions{
+		Parallelism: 3,
+		Progress:    func(p BatchProgress) { progress = append(progress, p) },
+	})
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, *maxInFlight, 3)
+
+	assert.Len(t, got, len(queries))
+	for i, result := range got {
+		assert.Equal(t, queries[i], result.Query)
+		if queries[i].From == "INVALID" {
+			assert.EqualError(t, result.Err, "No journey found")
+			assert.Nil(t, result.Itinerary)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.Equal(t, string(queries[i].From), strconv.Itoa(int(result.Itinerary.Journeys[0].Duration)))
+	}
+
+	assert.Len(t, progress, len(queries))
+	assert.Equal(t, BatchProgress{Completed: 7, Failed: 1, Total: 8}, progress[len(progress)-1])
+}
+
+func TestTflClient_PlanJourneys_cancelled(t *testing.T) {
+
+	stub, _ := batchStub(t, 0)
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := stubClient.PlanJourneys(ctx, batchQueries("1", "2", "3", "4"), BatchOptions{
+		Parallelism: 1,
+		Progress: func(p BatchProgress) {
+			if p.Completed == 2 {
+				cancel()
+			}
+		},
+	})
+	assert.Equal(t, context.Canceled, err)
+
+	assert.NoError(t, got[0].Err)
+	assert.NoError(t, got[1].Err)
+	assert.NotNil(t, got[1].Itinerary)
+	assert.Equal(t, context.Canceled, got[2].Err)
+	assert.Equal(t, context.Canceled, got[3].Err)
+}
+
+func TestTflClient_PlanJourneys_rateLimited(t *testing.T) {
+
+	stub, _ := batchStub(t, 0)
+	stubClient, err := New(WithBaseURL(stub.URL), WithRateLimit(5, 100*time.Millisecond))
+	assert.NoError(t, err)
+
+	start := time.Now()
+	got, err := stubClient.PlanJourneys(context.Background(), batchQueries("1", "2", "3", "4", "5", "6"), BatchOptions{Parallelism: 6})
+	assert.NoError(t, err)
+	assert.Len(t, got, 6)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	_, err = New(WithRateLimit(0, time.Minute))
+	assert.EqualError(t, err, "rate limit requests and period must be positive")
+
+	_, err = stubClient.PlanJourneys(context.Background(), batchQueries("1"), BatchOptions{Parallelism: -1})
+	assert.EqualError(t, err, "batch parallelism must not be negative")
+}
+
+func Test_rateLimiter_cancelled(t *testing.T) {
+
+	limiter := newRateLimiter(1, time.Hour)
+	assert.NoError(t, limiter.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.wait(ctx))
+}
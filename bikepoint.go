@@ -0,0 +1,129 @@
+package tfl
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// NearestBikePointsInput is used as the input object for NearestBikePointsWithAvailability
+type NearestBikePointsInput struct {
+	Lat, Lon      float64
+	MinBikes      int
+	MinEBikes     int
+	MinEmptyDocks int
+	Limit         int
+}
+
+// UnmarshalJSON decodes a BikePoint and parses the availability out of its AdditionalProperties
+func (b *BikePoint) UnmarshalJSON(data []byte) error {
+	type bikePoint BikePoint
+	decoded := bikePoint{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*b = BikePoint(decoded)
+
+	for _, property := range b.AdditionalProperties {
+		switch property.Key {
+		case "TerminalName":
+			b.TerminalName = property.Value
+		case "Installed":
+			b.Installed, _ = strconv.ParseBool(property.Value)
+		case "Locked":
+			b.Locked, _ = strconv.ParseBool(property.Value)
+		case "NbBikes":
+			b.NbBikes, _ = strconv.Atoi(property.Value)
+		case "NbStandardBikes":
+			b.NbStandardBikes, _ = strconv.Atoi(property.Value)
+		case "NbEBikes":
+			b.NbEBikes, _ = strconv.Atoi(property.Value)
+		case "NbEmptyDocks":
+			b.NbEmptyDocks, _ = strconv.Atoi(property.Value)
+		case "NbDocks":
+			b.NbDocks, _ = strconv.Atoi(property.Value)
+		}
+	}
+	return nil
+}
+
+// GetBikePoints retrieves all BikePoints
+// It queries the endpoint /BikePoint
+func (c *TflClient) GetBikePoints() (*[]BikePoint, error) {
+
+	pathParams := []string{bikePointPath}
+	url := c.buildURL(pathParams)
+
+	resp := []BikePoint{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetBikePointForID retrieves the BikePoint for a given ID
+// It queries the endpoint /BikePoint/{id}
+func (c *TflClient) GetBikePointForID(id string) (*BikePoint, error) {
+
+	pathParams := []string{bikePointPath, id}
+	url := c.buildURL(pathParams)
+
+	resp := BikePoint{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SearchBikePoints retrieves BikePoints whose name matches the search term
+// It queries the endpoint /BikePoint/Search
+func (c *TflClient) SearchBikePoints(searchTerm string) (*[]BikePoint, error) {
+
+	pathParams := []string{bikePointPath, searchPath}
+	queryParams := &map[string]string{
+		"query": searchTerm,
+	}
+	url := c.buildURLWithQueryParams(pathParams, queryParams)
+
+	resp := []BikePoint{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// NearestBikePointsWithAvailability retrieves the BikePoints closest to a coordinate
+// that have at least the requested bikes, e-bikes and empty docks, ordered by distance
+// It queries the endpoint /BikePoint
+func (c *TflClient) NearestBikePointsWithAvailability(input NearestBikePointsInput) (*[]BikePoint, error) {
+
+	bikePoints, err := c.GetBikePoints()
+	if err != nil {
+		return nil, err
+	}
+
+	origin := Coordinate{Lat: input.Lat, Lon: input.Lon}
+	available := []BikePoint{}
+	for _, bikePoint := range *bikePoints {
+		if bikePoint.Locked ||
+			bikePoint.NbBikes < input.MinBikes ||
+			bikePoint.NbEBikes < input.MinEBikes ||
+			bikePoint.NbEmptyDocks < input.MinEmptyDocks {
+			continue
+		}
+		bikePoint.Distance = origin.DistanceTo(Coordinate{Lat: bikePoint.Lat, Lon: bikePoint.Lon})
+		available = append(available, bikePoint)
+	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].Distance < available[j].Distance
+	})
+	if input.Limit > 0 && len(available) > input.Limit {
+		available = available[:input.Limit]
+	}
+
+	return &available, nil
+}
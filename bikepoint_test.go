@@ -0,0 +1,80 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetBikePoints(t *testing.T) {
+
+	expected := []BikePoint{}
+	json.Unmarshal(getTestDataFileContents("bike_points.json"), &expected)
+
+	got, err := client.GetBikePoints()
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestTflClient_GetBikePointForID(t *testing.T) {
+
+	got, err := client.GetBikePointForID("BikePoints_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "River Street , Clerkenwell", got.CommonName)
+	assert.Equal(t, "001023", got.TerminalName)
+	assert.True(t, got.Installed)
+	assert.False(t, got.Locked)
+	assert.Equal(t, 10, got.NbBikes)
+	assert.Equal(t, 8, got.NbStandardBikes)
+	assert.Equal(t, 2, got.NbEBikes)
+	assert.Equal(t, 8, got.NbEmptyDocks)
+	assert.Equal(t, 19, got.NbDocks)
+}
+
+func TestTflClient_SearchBikePoints(t *testing.T) {
+
+	got, err := client.SearchBikePoints("Christopher")
+	assert.NoError(t, err)
+	assert.Len(t, *got, 1)
+	assert.Equal(t, "BikePoints_3", (*got)[0].ID)
+}
+
+func TestTflClient_NearestBikePointsWithAvailability(t *testing.T) {
+
+	liverpoolStreet := NearestBikePointsInput{Lat: 51.518, Lon: -0.081}
+
+	tests := []struct {
+		name  string
+		input NearestBikePointsInput
+		want  []string
+	}{
+		{
+			name:  "Should order bike points by distance",
+			input: liverpoolStreet,
+			want:  []string{"BikePoints_3", "BikePoints_1", "BikePoints_2"},
+		},
+		{
+			name:  "Should exclude bike points without enough bikes",
+			input: NearestBikePointsInput{Lat: liverpoolStreet.Lat, Lon: liverpoolStreet.Lon, MinBikes: 1},
+			want:  []string{"BikePoints_3", "BikePoints_1"},
+		},
+		{
+			name:  "Should exclude bike points without e-bikes and limit results",
+			input: NearestBikePointsInput{Lat: liverpoolStreet.Lat, Lon: liverpoolStreet.Lon, MinEBikes: 1, Limit: 1},
+			want:  []string{"BikePoints_1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.NearestBikePointsWithAvailability(tt.input)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, bikePoint := range *got {
+				ids = append(ids, bikePoint.ID)
+				assert.NotZero(t, bikePoint.Distance)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
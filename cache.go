@@ -0,0 +1,98 @@
+package tfl
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+// responseCache holds response bodies by URL until they expire, dropping the oldest once full
+type responseCache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]cachedResponse
+	// order holds the URLs oldest first, entries replaced since leave stale URLs that are skipped
+	order []cachedURL
+}
+
+type cachedResponse struct {
+	body    []byte
+	expires time.Time
+}
+
+type cachedURL struct {
+	url     string
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	return &responseCache{ttl: ttl, maxEntries: maxEntries, entries: map[string]cachedResponse{}}
+}
+
+// get returns the body cached for the URL if it has not expired
+func (r *responseCache) get(url string) ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[url]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// set caches the body for the URL
+// Every entry lives for the same ttl, so the oldest are expired or evicted from the front of the order
+func (r *responseCache) set(url string, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for len(r.order) > 0 {
+		oldest := r.order[0]
+		entry, ok := r.entries[oldest.url]
+		stale := !ok || !entry.expires.Equal(oldest.expires)
+		if !stale && !now.After(oldest.expires) && len(r.entries) < r.maxEntries {
+			break
+		}
+		if !stale {
+			delete(r.entries, oldest.url)
+		}
+		r.order = r.order[1:]
+	}
+
+	expires := now.Add(r.ttl)
+	r.entries[url] = cachedResponse{body: body, expires: expires}
+	r.order = append(r.order, cachedURL{url: url, expires: expires})
+}
+
+// getCachedJSONContext is getJSONContext answered from the client's cache when it has one
+func (c *TflClient) getCachedJSONContext(ctx context.Context, url string, respObj interface{}) error {
+
+	if c.cache == nil {
+		return c.getJSONContext(ctx, url, respObj)
+	}
+	if body, ok := c.cache.get(url); ok {
+		return json.Unmarshal(body, respObj)
+	}
+
+	stream, err := c.getStreamContext(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	body, err := ioutil.ReadAll(newLimitedBody(stream, c.maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, respObj); err != nil {
+		return err
+	}
+	c.cache.set(url, body)
+	return nil
+}
@@ -0,0 +1,283 @@
+package tfl
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// hopperWindow is the time from the first bus or tram touch in which further bus and tram trips are free
+	hopperWindow = 60 * time.Minute
+	// fareDayStart is the offset from midnight at which a new fare day begins
+	fareDayStart = 4*time.Hour + 30*time.Minute
+)
+
+// FareCap is a pay as you go cap that applies to travel between LowZone and HighZone
+type FareCap struct {
+	LowZone, HighZone uint8
+	Daily, Weekly     uint16
+}
+
+// FareTable holds the caps used when calculating pay as you go charges
+// Costs are in pence, a zero cap is treated as no cap
+type FareTable struct {
+	Caps      []FareCap
+	BusDaily  uint16
+	BusWeekly uint16
+}
+
+// DefaultFareTable holds the adult contactless caps for the 2020 fares year
+var DefaultFareTable = FareTable{
+	Caps: []FareCap{
+		{LowZone: 1, HighZone: 2, Daily: 700, Weekly: 3510},
+		{LowZone: 1, HighZone: 3, Daily: 820, Weekly: 4120},
+		{LowZone: 1, HighZone: 4, Daily: 1010, Weekly: 5050},
+		{LowZone: 1, HighZone: 5, Daily: 1200, Weekly: 6000},
+		{LowZone: 1, HighZone: 6, Daily: 1280, Weekly: 6420},
+	},
+	BusDaily:  465,
+	BusWeekly: 2120,
+}
+
+// FareTrip is a single pay as you go trip to be capped
+type FareTrip struct {
+	Time              time.Time
+	LowZone, HighZone uint8
+	Cost              uint16
+	IsBus             bool // bus and tram trips are eligible for the Hopper fare
+}
+
+// CappedTrip is a FareTrip with the amount that is actually charged for it
+type CappedTrip struct {
+	FareTrip
+	Charged      uint16
+	Hopper       bool
+	DailyCapped  bool
+	WeeklyCapped bool
+}
+
+// CappingResult holds the outcome of applying a FareTable to a sequence of trips
+type CappingResult struct {
+	Trips    []CappedTrip
+	Uncapped uint32
+	Total    uint32
+}
+
+// capSpend tracks the spend and zones travelled within a capping period
+type capSpend struct {
+	rail      bool
+	low, high uint8
+	total     uint32
+	bus       uint32
+}
+
+// FareTripsFromFares converts fares into trips, using the first tap as the time of the trip
+func FareTripsFromFares(fares []Fare) ([]FareTrip, error) {
+	trips := []FareTrip{}
+	for _, fare := range fares {
+		if len(fare.Taps) == 0 {
+			return nil, errors.New("fare has no taps to take the time from")
+		}
+		tapTime, err := parseTflTime(fare.Taps[0].TapDetails.TapTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		trips = append(trips, fareTrip(fare, tapTime))
+	}
+	return trips, nil
+}
+
+// FareTripsFromJourneys converts the fares of each journey into trips
+// Fares without taps take the start time of their journey
+func FareTripsFromJourneys(journeys []JourneyPlannerJourney) ([]FareTrip, error) {
+	trips := []FareTrip{}
+	for _, journey := range journeys {
+		for _, fare := range journey.Fare.Fares {
+			value := journey.StartDateTime
+			if len(fare.Taps) > 0 {
+				value = fare.Taps[0].TapDetails.TapTimestamp
+			}
+			tripTime, err := parseTflTime(value)
+			if err != nil {
+				return nil, err
+			}
+			trips = append(trips, fareTrip(fare, tripTime))
+		}
+	}
+	return trips, nil
+}
+
+func fareTrip(fare Fare, tripTime time.Time) FareTrip {
+	isBus := false
+	if len(fare.Taps) > 0 {
+		isBus = isHopperMode(fare.Taps[0].TapDetails.ModeType)
+	}
+	return FareTrip{
+		Time:     tripTime,
+		LowZone:  fare.LowZone,
+		HighZone: fare.HighZone,
+		Cost:     fare.Cost,
+		IsBus:    isBus,
+	}
+}
+
+// isHopperMode reports whether the mode is covered by the Hopper fare
+func isHopperMode(mode string) bool {
+	switch strings.ToLower(mode) {
+	case "bus", "tram":
+		return true
+	}
+	return false
+}
+
+// CalculateCappedFares applies the Hopper fare, daily caps and Monday to Sunday weekly caps to the trips
+// Trips are charged in time order, fare days run from 04:30 to 04:29 the following morning
+func CalculateCappedFares(trips []FareTrip, table FareTable) *CappingResult {
+
+	sorted := make([]FareTrip, len(trips))
+	copy(sorted, trips)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	result := &CappingResult{Trips: []CappedTrip{}}
+	days := map[time.Time]*capSpend{}
+	weeks := map[time.Time]*capSpend{}
+	var hopperStart *time.Time
+
+	for i, trip := range sorted {
+		capped := CappedTrip{FareTrip: trip}
+		charge := uint32(trip.Cost)
+
+		if trip.IsBus {
+			if hopperStart != nil && trip.Time.Sub(*hopperStart) <= hopperWindow {
+				charge = 0
+				capped.Hopper = true
+			} else {
+				hopperStart = &sorted[i].Time
+			}
+		}
+
+		day := spendFor(days, fareDay(trip.Time))
+		week := spendFor(weeks, fareWeek(trip.Time))
+		day.addZones(trip)
+		week.addZones(trip)
+
+		if allowance, ok := table.allowance(day, trip.IsBus, true); ok && allowance < charge {
+			charge = allowance
+			capped.DailyCapped = true
+		}
+		if allowance, ok := table.allowance(week, trip.IsBus, false); ok && allowance < charge {
+			charge = allowance
+			capped.WeeklyCapped = true
+		}
+
+		day.spend(trip, charge)
+		week.spend(trip, charge)
+
+		capped.Charged = uint16(charge)
+		result.Trips = append(result.Trips, capped)
+		result.Uncapped += uint32(trip.Cost)
+		result.Total += charge
+	}
+
+	return result
+}
+
+func spendFor(periods map[time.Time]*capSpend, start time.Time) *capSpend {
+	if _, ok := periods[start]; !ok {
+		periods[start] = &capSpend{}
+	}
+	return periods[start]
+}
+
+func (s *capSpend) addZones(trip FareTrip) {
+	if trip.IsBus || trip.LowZone == 0 {
+		return
+	}
+	if !s.rail || trip.LowZone < s.low {
+		s.low = trip.LowZone
+	}
+	if !s.rail || trip.HighZone > s.high {
+		s.high = trip.HighZone
+	}
+	s.rail = true
+}
+
+func (s *capSpend) spend(trip FareTrip, charge uint32) {
+	s.total += charge
+	if trip.IsBus {
+		s.bus += charge
+	}
+}
+
+// allowance returns how much more can be charged in the period before a cap is reached
+// The boolean is false when no cap applies
+func (t FareTable) allowance(s *capSpend, isBus, daily bool) (uint32, bool) {
+	var allowance uint32
+	limited := false
+
+	apply := func(capCost uint16, spent uint32) {
+		if capCost == 0 {
+			return
+		}
+		remaining := uint32(0)
+		if uint32(capCost) > spent {
+			remaining = uint32(capCost) - spent
+		}
+		if !limited || remaining < allowance {
+			allowance = remaining
+			limited = true
+		}
+	}
+
+	if isBus {
+		if daily {
+			apply(t.BusDaily, s.bus)
+		} else {
+			apply(t.BusWeekly, s.bus)
+		}
+	}
+	if s.rail {
+		if fareCap, ok := t.capFor(s.low, s.high, daily); ok {
+			if daily {
+				apply(fareCap.Daily, s.total)
+			} else {
+				apply(fareCap.Weekly, s.total)
+			}
+		}
+	}
+
+	return allowance, limited
+}
+
+// capFor returns the cheapest cap covering travel between the zones
+func (t FareTable) capFor(low, high uint8, daily bool) (FareCap, bool) {
+	var found FareCap
+	ok := false
+	for _, fareCap := range t.Caps {
+		if fareCap.LowZone > low || fareCap.HighZone < high {
+			continue
+		}
+		if !ok || (daily && fareCap.Daily < found.Daily) || (!daily && fareCap.Weekly < found.Weekly) {
+			found = fareCap
+			ok = true
+		}
+	}
+	return found, ok
+}
+
+// fareDay returns the start of the fare day the time falls in, as midnight of that calendar day
+func fareDay(t time.Time) time.Time {
+	d := t.Add(-fareDayStart)
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+}
+
+// fareWeek returns the Monday of the fare week the time falls in
+func fareWeek(t time.Time) time.Time {
+	day := fareDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
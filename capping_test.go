@@ -0,0 +1,159 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testFareTable = FareTable{
+	Caps: []FareCap{
+		{LowZone: 1, HighZone: 2, Daily: 700, Weekly: 3510},
+		{LowZone: 1, HighZone: 6, Daily: 1280, Weekly: 6420},
+	},
+	BusDaily:  465,
+	BusWeekly: 2120,
+}
+
+func at(value string) time.Time {
+	t, err := parseTflTime(value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func chargedFor(result *CappingResult) []uint16 {
+	charged := []uint16{}
+	for _, trip := range result.Trips {
+		charged = append(charged, trip.Charged)
+	}
+	return charged
+}
+
+func TestCalculateCappedFares(t *testing.T) {
+
+	weekOfTrips := []FareTrip{}
+	for day := 0; day < 7; day++ {
+		weekOfTrips = append(weekOfTrips, FareTrip{
+			Time:     at("2020-08-17T08:00:00").AddDate(0, 0, day),
+			LowZone:  1,
+			HighZone: 2,
+			Cost:     700,
+		})
+	}
+
+	type args struct {
+		trips []FareTrip
+		table FareTable
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantTotal uint32
+		want      []uint16
+	}{
+		{
+			name: "Should make bus trips within the hopper window free",
+			args: args{
+				trips: []FareTrip{
+					{Time: at("2020-08-17T10:00:00"), Cost: 150, IsBus: true},
+					{Time: at("2020-08-17T10:59:00"), Cost: 150, IsBus: true},
+					{Time: at("2020-08-17T11:10:00"), Cost: 150, IsBus: true},
+				},
+				table: testFareTable,
+			},
+			wantTotal: 300,
+			want:      []uint16{150, 0, 150},
+		},
+		{
+			name: "Should cap rail trips at the daily cap",
+			args: args{
+				trips: []FareTrip{
+					{Time: at("2020-08-17T08:00:00"), LowZone: 1, HighZone: 2, Cost: 240},
+					{Time: at("2020-08-17T12:00:00"), LowZone: 1, HighZone: 2, Cost: 240},
+					{Time: at("2020-08-17T18:00:00"), LowZone: 1, HighZone: 2, Cost: 240},
+					{Time: at("2020-08-17T20:00:00"), LowZone: 1, HighZone: 2, Cost: 240},
+				},
+				table: testFareTable,
+			},
+			wantTotal: 700,
+			want:      []uint16{240, 240, 220, 0},
+		},
+		{
+			name: "Should cap bus only days at the bus cap",
+			args: args{
+				trips: []FareTrip{
+					{Time: at("2020-08-17T08:00:00"), Cost: 150, IsBus: true},
+					{Time: at("2020-08-17T12:00:00"), Cost: 150, IsBus: true},
+					{Time: at("2020-08-17T16:00:00"), Cost: 150, IsBus: true},
+					{Time: at("2020-08-17T20:00:00"), Cost: 150, IsBus: true},
+				},
+				table: testFareTable,
+			},
+			wantTotal: 465,
+			want:      []uint16{150, 150, 150, 15},
+		},
+		{
+			name: "Should count trips before 04:30 towards the previous day",
+			args: args{
+				trips: []FareTrip{
+					{Time: at("2020-08-17T20:00:00"), LowZone: 1, HighZone: 2, Cost: 600},
+					{Time: at("2020-08-18T03:00:00"), LowZone: 1, HighZone: 2, Cost: 300},
+					{Time: at("2020-08-18T05:00:00"), LowZone: 1, HighZone: 2, Cost: 300},
+				},
+				table: testFareTable,
+			},
+			wantTotal: 1000,
+			want:      []uint16{600, 100, 300},
+		},
+		{
+			name: "Should raise the daily cap when travelling into further zones",
+			args: args{
+				trips: []FareTrip{
+					{Time: at("2020-08-17T08:00:00"), LowZone: 1, HighZone: 2, Cost: 700},
+					{Time: at("2020-08-17T12:00:00"), LowZone: 1, HighZone: 6, Cost: 700},
+				},
+				table: testFareTable,
+			},
+			wantTotal: 1280,
+			want:      []uint16{700, 580},
+		},
+		{
+			name: "Should cap a Monday to Sunday week at the weekly cap",
+			args: args{
+				trips: weekOfTrips,
+				table: testFareTable,
+			},
+			wantTotal: 3510,
+			want:      []uint16{700, 700, 700, 700, 700, 10, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateCappedFares(tt.args.trips, tt.args.table)
+			assert.Equal(t, tt.wantTotal, got.Total)
+			assert.Equal(t, tt.want, chargedFor(got))
+		})
+	}
+}
+
+func TestFareTripsFromJourneys(t *testing.T) {
+
+	itinerary := JourneyPlannerItineraryResult{}
+	json.Unmarshal(getTestDataFileContents("Should_retrieve_journey_planner_itinerary_for_valid_search.json"), &itinerary)
+
+	got, err := FareTripsFromJourneys(itinerary.Journeys[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, []FareTrip{
+		{Time: at("2019-04-01T07:04:00"), LowZone: 1, HighZone: 5, Cost: 700},
+	}, got)
+}
+
+func TestFareTripsFromFares(t *testing.T) {
+
+	_, err := FareTripsFromFares([]Fare{{Cost: 150}})
+	assert.EqualError(t, err, "fare has no taps to take the time from")
+}
@@ -1,10 +1,11 @@
 package tfl
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -12,14 +13,41 @@ import (
 )
 
 const (
-	apiURL             string = "https://api.tfl.gov.uk"
-	journeyResultsPath string = "Journey/JourneyResults"
-	toPath             string = "to"
-	stopPointPath      string = "StopPoint"
-	searchPath         string = "Search"
-	fareToPath         string = "FareTo"
+	apiURL              string = "https://api.tfl.gov.uk"
+	journeyResultsPath  string = "Journey/JourneyResults"
+	toPath              string = "to"
+	stopPointPath       string = "StopPoint"
+	searchPath          string = "Search"
+	fareToPath          string = "FareTo"
+	bikePointPath       string = "BikePoint"
+	roadPath            string = "Road"
+	statusPath          string = "Status"
+	disruptionPath      string = "Disruption"
+	linePath            string = "Line"
+	routePath           string = "Route"
+	sequencePath        string = "Sequence"
+	timetablePath       string = "Timetable"
+	crowdingPath        string = "Crowding"
+	livePath            string = "Live"
+	modePath            string = "Mode"
+	arrivalsPath        string = "Arrivals"
+	vehiclePath         string = "Vehicle"
+	placePath           string = "Place"
+	typePath            string = "Type"
+	occupancyPath       string = "Occupancy"
+	carParkPath         string = "CarPark"
+	chargeConnectorPath string = "ChargeConnector"
+	bikePointsPath      string = "BikePoints"
+	airQualityPath      string = "AirQuality"
+	accidentStatsPath   string = "AccidentStats"
+
+	// defaultMaxResponseSize is the largest response body read before a request fails
+	defaultMaxResponseSize int64 = 64 << 20
 )
 
+// ErrResponseTooLarge is returned when a response body exceeds the client's maximum response size
+var ErrResponseTooLarge = errors.New("response exceeds the maximum response size")
+
 // Option is a functional option for configuring the API client
 type Option func(*TflClient) error
 
@@ -48,6 +76,43 @@ func WithAppKey(appKey string) Option {
 	}
 }
 
+// WithMaxResponseSize sets the largest response body in bytes the client will read into memory
+// Streamed responses, such as IterateBikePoints, are decoded as they are read and are not limited
+// Zero removes the limit
+func WithMaxResponseSize(size int64) Option {
+	return func(c *TflClient) error {
+		if size < 0 {
+			return errors.New("maximum response size must not be negative")
+		}
+		c.maxResponseSize = size
+		return nil
+	}
+}
+
+// WithRateLimit spaces requests evenly so no more than requests are made in each period
+// TfL allows 500 requests a minute for registered applications
+func WithRateLimit(requests int, period time.Duration) Option {
+	return func(c *TflClient) error {
+		if requests <= 0 || period <= 0 {
+			return errors.New("rate limit requests and period must be positive")
+		}
+		c.limiter = newRateLimiter(requests, period)
+		return nil
+	}
+}
+
+// WithCache keeps up to maxEntries journey planner responses in memory for the ttl so repeated plans are not requested again
+// Live endpoints such as arrivals, statuses and disruptions are never cached
+func WithCache(ttl time.Duration, maxEntries int) Option {
+	return func(c *TflClient) error {
+		if ttl <= 0 || maxEntries <= 0 {
+			return errors.New("cache ttl and max entries must be positive")
+		}
+		c.cache = newResponseCache(ttl, maxEntries)
+		return nil
+	}
+}
+
 func (c *TflClient) parseOptions(opts ...Option) error {
 	for _, option := range opts {
 		err := option(c)
@@ -64,6 +129,46 @@ type Api interface {
 	GetStopPointForID(string) (*StopPointAPIResponse, error)
 	GetJourneyPlannerItinerary(JourneyPlannerQuery) (*JourneyPlannerItineraryResult, error)
 	SingleFareFinder(SingleFareFinderInput) (*[]FaresSection, error)
+	ComparePeakFares(JourneyPlannerQuery, []time.Time) (*[]PeakFareComparison, error)
+	GetBikePoints() (*[]BikePoint, error)
+	GetBikePointForID(string) (*BikePoint, error)
+	SearchBikePoints(string) (*[]BikePoint, error)
+	NearestBikePointsWithAvailability(NearestBikePointsInput) (*[]BikePoint, error)
+	GetRoads() (*[]RoadCorridor, error)
+	GetRoadStatus(RoadStatusQuery) (*[]RoadCorridor, error)
+	GetRoadDisruptions(RoadDisruptionQuery) (*[]RoadDisruption, error)
+	GetLineRouteSequence(string, string) (*RouteSequence, error)
+	GetStopsBetween(string, string, string) (*[]StopPointAPIResponse, error)
+	GetTimetable(string, string) (*TimetableResponse, error)
+	GetTimetableTo(string, string, string) (*TimetableResponse, error)
+	GetCrowding(string) (*StationCrowding, error)
+	GetCrowdingForDay(string, time.Weekday) (*StationCrowdingDay, error)
+	GetLiveCrowding(string) (*LiveCrowding, error)
+	GetLineDisruptionsByMode([]string) (*[]Disruption, error)
+	GetStopPointDisruptionsByMode([]string) (*[]DisruptedPoint, error)
+	GetLineStatus([]string) (*[]Line, error)
+	GetLineStatusByMode([]string) (*[]Line, error)
+	GetArrivals(string) (*[]Prediction, error)
+	GetVehicleArrivals([]string) (*[]Prediction, error)
+	GetPlaceForID(string) (*Place, error)
+	GetPlacesWithinRadius(PlaceRadiusQuery) (*[]Place, error)
+	GetPlacesWithinBounds(PlaceBoundsQuery) (*[]Place, error)
+	SearchPlaces(string, []string) (*[]Place, error)
+	GetPlacesByType([]string, bool) (*[]Place, error)
+	GetCarParkOccupancies() (*[]CarParkOccupancy, error)
+	GetCarParkOccupancy(string) (*CarParkOccupancy, error)
+	GetChargeConnectorOccupancies() (*[]ChargeConnectorOccupancy, error)
+	GetBikePointOccupancies([]string) (*[]BikePointOccupancy, error)
+	GetCarParkAvailability(string) (*CarParkAvailability, error)
+	GetAirQuality() (*AirQuality, error)
+	GetAccidentStats(int, AccidentFilter) (*[]AccidentDetail, error)
+	StreamAccidentStats(int, AccidentFilter) (*AccidentStream, error)
+	IterateBikePoints() (*BikePointIterator, error)
+	GetStepFreeJourneys(JourneyPlannerQuery) (*[]StepFreeJourney, error)
+	GetLatestDepartures(ArriveByQuery) (*[]ArriveByJourney, error)
+	PlanJourneys(context.Context, []JourneyPlannerQuery, BatchOptions) ([]BatchResult, error)
+	GetTravelTimeMatrix(context.Context, TravelTimeQuery, BatchOptions) (*TravelTimeMatrix, error)
+	IteratePlacesByType([]string, bool) (*PlaceIterator, error)
 }
 
 // Client holds information necessary to make a request to your API
@@ -72,6 +177,10 @@ type TflClient struct {
 	baseURL *url.URL
 	appID   string
 	appKey  string
+
+	maxResponseSize int64
+	limiter         *rateLimiter
+	cache           *responseCache
 }
 
 // New returns a new instance of the Client
@@ -79,7 +188,8 @@ func New(opts ...Option) (*TflClient, error) {
 	parsedURL, _ := url.Parse(apiURL)
 
 	c := &TflClient{
-		baseURL: parsedURL,
+		baseURL:         parsedURL,
+		maxResponseSize: defaultMaxResponseSize,
 		Client: &http.Client{
 			Timeout: time.Second * 30,
 		},
@@ -98,7 +208,8 @@ func (c *TflClient) buildURL(pathParams []string) string {
 
 func (c *TflClient) buildURLWithQueryParams(pathParams []string, queryParams *map[string]string) string {
 
-	builtURL := c.baseURL
+	// Copy the base URL so concurrent requests do not share it
+	builtURL := *c.baseURL
 	builtURL.Path = strings.Join(pathParams, "/")
 
 	params := url.Values{}
@@ -115,35 +226,117 @@ func (c *TflClient) buildURLWithQueryParams(pathParams []string, queryParams *ma
 // getJSON wraps around the client to execute the GET request and maps the result to the provided interface type
 // Also handles a non-OK response from the API and extracts the error if so
 func (c *TflClient) getJSON(url string, respObj interface{}) error {
+	return c.getJSONContext(context.Background(), url, respObj)
+}
 
-	fmt.Printf("GET - %s\n", url)
-	resp, err := c.Client.Get(url)
+// getJSONContext is getJSON with a context that cancels the request and any wait for the rate limiter
+func (c *TflClient) getJSONContext(ctx context.Context, url string, respObj interface{}) error {
+
+	body, err := c.getStreamContext(ctx, url)
 	if err != nil {
 		return err
 	}
+	defer body.Close()
+
+	return json.NewDecoder(newLimitedBody(body, c.maxResponseSize)).Decode(respObj)
+}
+
+// getStream requests the URL and returns the body unread so large responses can be decoded incrementally
+// The maximum response size only applies to an error response, the caller must close the body
+func (c *TflClient) getStream(url string) (io.ReadCloser, error) {
+	return c.getStreamContext(context.Background(), url)
+}
+
+// getStreamContext is getStream with a context that cancels the request and any wait for the rate limiter
+func (c *TflClient) getStreamContext(ctx context.Context, url string) (io.ReadCloser, error) {
+
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("GET - %s\n", url)
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
+		resp.Body = newLimitedBody(resp.Body, c.maxResponseSize)
 		errObj := APIErrorResponse{}
 		if err := serialiseResponse(resp, &errObj); err != nil {
-			return err
+			return nil, err
 		}
-		return errors.New(errObj.Message)
+		return nil, errors.New(errObj.Message)
 	}
 
-	return serialiseResponse(resp, &respObj)
+	return resp.Body, nil
 }
 
 // serialiseResponse takes in a response and attempts to serialise it to the provided interface
 func serialiseResponse(resp *http.Response, obj interface{}) error {
 
 	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	return json.NewDecoder(resp.Body).Decode(obj)
+}
 
-	if err != nil {
-		return err
+// limitedBody fails reads once more than max bytes have been read from the body
+type limitedBody struct {
+	body io.ReadCloser
+	read int64
+	max  int64
+}
+
+func newLimitedBody(body io.ReadCloser, max int64) io.ReadCloser {
+	if max <= 0 {
+		return body
 	}
+	return &limitedBody{body: body, max: max}
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if l.read >= l.max {
+		// Probe for a byte past the limit so a body of exactly max bytes is accepted
+		var probe [1]byte
+		if n, err := l.body.Read(probe[:]); n == 0 {
+			return 0, err
+		}
+		return 0, ErrResponseTooLarge
+	}
+	if remaining := l.max - l.read; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.body.Read(p)
+	l.read += int64(n)
+	return n, err
+}
+
+func (l *limitedBody) Close() error {
+	return l.body.Close()
+}
 
-	return json.Unmarshal(body, &obj)
+// sendLatestError sends err on a channel buffered to hold one error without blocking
+// An error nobody has read yet is replaced, so a caller only reading the other channel is never held up
+// The channel must only be sent on by the caller
+func sendLatestError(errs chan error, err error) {
+	select {
+	case errs <- err:
+		return
+	default:
+	}
+	select {
+	case <-errs:
+	default:
+	}
+	errs <- err
 }
 
 // GetStopPointForID retrieves the StopPoint information for a given ID
@@ -197,8 +390,25 @@ func (c *TflClient) SearchStopPointsWithModes(searchTerm string, modes []string)
 // GetJourneyPlannerItinerary retrieves MatchedStops for a given search term
 // It queries the endpoint /Journey/JourneyResult/{from}/to/{to}
 func (c *TflClient) GetJourneyPlannerItinerary(query JourneyPlannerQuery) (*JourneyPlannerItineraryResult, error) {
+	return c.getJourneyPlannerItinerary(context.Background(), query)
+}
+
+func (c *TflClient) getJourneyPlannerItinerary(ctx context.Context, query JourneyPlannerQuery) (*JourneyPlannerItineraryResult, error) {
+
+	if err := query.CyclePreference.validate(); err != nil {
+		return nil, err
+	}
+	if err := query.BikeProficiency.validate(); err != nil {
+		return nil, err
+	}
+	if err := query.StepFree.validate(); err != nil {
+		return nil, err
+	}
+	if err := query.TimeIs.validate(); err != nil {
+		return nil, err
+	}
 
-	pathParams := []string{journeyResultsPath, query.From, toPath, query.To}
+	pathParams := []string{journeyResultsPath, string(query.From), toPath, string(query.To)}
 	// TODO validate query:
 	// - date and time are mandatory
 	// - modes must be from valid list
@@ -210,10 +420,22 @@ func (c *TflClient) GetJourneyPlannerItinerary(query JourneyPlannerQuery) (*Jour
 	if query.Modes != nil && len(query.Modes) > 0 {
 		(*queryParams)["mode"] = strings.Join(query.Modes, ",")
 	}
+	if query.CyclePreference != "" {
+		(*queryParams)["cyclePreference"] = string(query.CyclePreference)
+	}
+	if query.BikeProficiency != "" {
+		(*queryParams)["bikeProficiency"] = string(query.BikeProficiency)
+	}
+	if query.TimeIs != "" {
+		(*queryParams)["timeIs"] = string(query.TimeIs)
+	}
+	if preferences := query.accessibilityPreferences(); len(preferences) > 0 {
+		(*queryParams)["accessibilityPreference"] = strings.Join(preferences, ",")
+	}
 	url := c.buildURLWithQueryParams(pathParams, queryParams)
 
 	resp := JourneyPlannerItineraryResult{}
-	if err := c.getJSON(url, &resp); err != nil {
+	if err := c.getCachedJSONContext(ctx, url, &resp); err != nil {
 		return nil, err
 	}
 
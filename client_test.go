@@ -54,6 +54,73 @@ func TflAPIClientStub() func() {
 			resp = getTestDataFileContents("Should_retrieve_journey_planner_itinerary_for_valid_search.json")
 		case fmt.Sprintf("/StopPoint/940GZZLUCYF/FareTo/910GPURLEYO?app_id=%s&app_key=%s", appID, appKey):
 			resp = getTestDataFileContents("single_fare_finder.json")
+		case fmt.Sprintf("/BikePoint?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("bike_points.json")
+		case fmt.Sprintf("/BikePoint/BikePoints_1?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("bike_point.json")
+		case fmt.Sprintf("/BikePoint/Search?app_id=%s&app_key=%s&query=%s", appID, appKey, "Christopher"):
+			resp = getTestDataFileContents("bike_point_search.json")
+		case fmt.Sprintf("/Road?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("roads.json")
+		case fmt.Sprintf("/Road/a1,a2/Status?app_id=%s&app_key=%s&dateRangeNullable.endDate=%s&dateRangeNullable.startDate=%s", appID, appKey, "2020-08-23T00%3A00%3A00", "2020-08-22T00%3A00%3A00"):
+			resp = getTestDataFileContents("road_status.json")
+		case fmt.Sprintf("/Road/all/Disruption?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("road_disruptions.json")
+		case fmt.Sprintf("/Road/all/Disruption?app_id=%s&app_key=%s&severities=%s", appID, appKey, "Minimal"):
+			resp = getTestDataFileContents("road_disruptions.json")
+		case fmt.Sprintf("/Line/victoria/Route/Sequence/all?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("line_route_sequence.json")
+		case fmt.Sprintf("/Line/victoria/Timetable/940GZZLUVIC?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("timetable.json")
+		case fmt.Sprintf("/Line/victoria/Timetable/940GZZLUVIC/to/940GZZLUOXC?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("timetable.json")
+		case fmt.Sprintf("/Crowding/940GZZLUVIC?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("crowding.json")
+		case fmt.Sprintf("/Crowding/940GZZLUVIC/MON?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("crowding_day.json")
+		case fmt.Sprintf("/Crowding/940GZZLUVIC/Live?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("crowding_live.json")
+		case fmt.Sprintf("/Line/Mode/tube/Disruption?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("line_disruptions.json")
+		case fmt.Sprintf("/StopPoint/Mode/tube/Disruption?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("stop_point_disruptions.json")
+		case fmt.Sprintf("/Line/northern,victoria/Status?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("line_status.json")
+		case fmt.Sprintf("/Line/Mode/tube/Status?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("line_status.json")
+		case fmt.Sprintf("/StopPoint/940GZZLUOXC/Arrivals?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("arrivals.json")
+		case fmt.Sprintf("/Vehicle/237/Arrivals?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("vehicle_arrivals.json")
+		case fmt.Sprintf("/Place/CarParks_800491?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("place.json")
+		case fmt.Sprintf("/Place/Unknown?app_id=%s&app_key=%s", appID, appKey):
+			resp = []byte("[]")
+		case fmt.Sprintf("/Place?app_id=%s&app_key=%s&lat=51.5446&lon=-0.0075&radius=500&type=%s", appID, appKey, "CarPark%2CTaxiRank"):
+			resp = getTestDataFileContents("places_radius.json")
+		case fmt.Sprintf("/Place?app_id=%s&app_key=%s&placeGeo.neLat=51.52&placeGeo.neLon=-0.1&placeGeo.swLat=51.49&placeGeo.swLon=-0.15", appID, appKey):
+			resp = getTestDataFileContents("places_bounds.json")
+		case fmt.Sprintf("/Place/Search?app_id=%s&app_key=%s&name=Stratford&types=CarPark", appID, appKey):
+			resp = getTestDataFileContents("places_search.json")
+		case fmt.Sprintf("/Place/Type/CarPark,ChargeConnector?activeOnly=true&app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("places_type.json")
+		case fmt.Sprintf("/Occupancy/CarPark?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("car_park_occupancies.json")
+		case fmt.Sprintf("/Occupancy/CarPark/CarParks_800491?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("car_park_occupancy.json")
+		case fmt.Sprintf("/Occupancy/ChargeConnector?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("charge_connector_occupancies.json")
+		case fmt.Sprintf("/Occupancy/BikePoints/BikePoints_1?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("bike_point_occupancies.json")
+		case fmt.Sprintf("/AirQuality?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("air_quality.json")
+		case fmt.Sprintf("/AccidentStats/2019?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("accident_stats.json")
+		case fmt.Sprintf("/Journey/JourneyResults/940GZZLUKSX/to/940GZZLUCHX?app_id=%s&app_key=%s&bikeProficiency=Moderate&cyclePreference=CycleHire&date=20200822&mode=%s&time=0900", appID, appKey, "cycle-hire%2Cwalking"):
+			resp = getTestDataFileContents("journey_cycle.json")
+		case fmt.Sprintf("/Road/INVALID/Disruption?app_id=%s&app_key=%s", appID, appKey):
+			resp = getTestDataFileContents("road_invalid_id.json")
+			w.WriteHeader(http.StatusNotFound)
 		}
 
 		w.Write(resp)
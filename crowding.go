@@ -0,0 +1,147 @@
+package tfl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// crowdingDayNames are the day of week names used by the crowding endpoints
+var crowdingDayNames = map[time.Weekday]string{
+	time.Monday:    "MON",
+	time.Tuesday:   "TUE",
+	time.Wednesday: "WED",
+	time.Thursday:  "THU",
+	time.Friday:    "FRI",
+	time.Saturday:  "SAT",
+	time.Sunday:    "SUN",
+}
+
+// CrowdingWindow is a period of the day and the average busyness of a station across it
+type CrowdingWindow struct {
+	Start, End           time.Duration
+	PercentageOfBaseLine float64
+}
+
+// Bounds returns the start and end of the time band as times after midnight
+func (b CrowdingTimeBand) Bounds() (time.Duration, time.Duration, error) {
+	parts := strings.Split(b.TimeBand, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid time band %q", b.TimeBand)
+	}
+	start, err := parseClock(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseClock(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	// Bands running up to midnight end at 00:00
+	if end <= start {
+		end += 24 * time.Hour
+	}
+	return start, end, nil
+}
+
+func parseClock(value string) (time.Duration, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	return clockTime(parts[0], parts[1])
+}
+
+// QuietestWindow returns the window of consecutive time bands, at least length long and within from and to,
+// with the lowest average busyness. Ties are resolved in favour of the earliest window
+// The boolean is false when no window fits in the range
+func (d CrowdingDay) QuietestWindow(from, to, length time.Duration) (CrowdingWindow, bool, error) {
+
+	type band struct {
+		start, end time.Duration
+		percentage float64
+	}
+	bands := []band{}
+	for _, timeBand := range d.TimeBands {
+		start, end, err := timeBand.Bounds()
+		if err != nil {
+			return CrowdingWindow{}, false, err
+		}
+		if start < from || end > to {
+			continue
+		}
+		bands = append(bands, band{start: start, end: end, percentage: timeBand.PercentageOfBaseLine})
+	}
+
+	var quietest CrowdingWindow
+	found := false
+	for i := range bands {
+		total := 0.0
+		for j := i; j < len(bands); j++ {
+			if j > i && bands[j].start != bands[j-1].end {
+				break
+			}
+			total += bands[j].percentage
+			if bands[j].end-bands[i].start < length {
+				continue
+			}
+			window := CrowdingWindow{
+				Start:                bands[i].start,
+				End:                  bands[j].end,
+				PercentageOfBaseLine: total / float64(j-i+1),
+			}
+			if !found || window.PercentageOfBaseLine < quietest.PercentageOfBaseLine {
+				quietest = window
+				found = true
+			}
+			break
+		}
+	}
+
+	return quietest, found, nil
+}
+
+// GetCrowding retrieves the typical busyness of a station for every day of the week
+// It queries the endpoint /Crowding/{naptan}
+func (c *TflClient) GetCrowding(naptan string) (*StationCrowding, error) {
+
+	pathParams := []string{crowdingPath, naptan}
+	url := c.buildURL(pathParams)
+
+	resp := StationCrowding{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCrowdingForDay retrieves the typical busyness of a station on a day of the week
+// It queries the endpoint /Crowding/{naptan}/{dayOfWeek}
+func (c *TflClient) GetCrowdingForDay(naptan string, day time.Weekday) (*StationCrowdingDay, error) {
+
+	pathParams := []string{crowdingPath, naptan, crowdingDayNames[day]}
+	url := c.buildURL(pathParams)
+
+	resp := StationCrowdingDay{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetLiveCrowding retrieves the current busyness of a station
+// It queries the endpoint /Crowding/{naptan}/Live
+func (c *TflClient) GetLiveCrowding(naptan string) (*LiveCrowding, error) {
+
+	pathParams := []string{crowdingPath, naptan, livePath}
+	url := c.buildURL(pathParams)
+
+	resp := LiveCrowding{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,93 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetCrowding(t *testing.T) {
+
+	expected := StationCrowding{}
+	json.Unmarshal(getTestDataFileContents("crowding.json"), &expected)
+
+	got, err := client.GetCrowding("940GZZLUVIC")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+	assert.Len(t, got.DaysOfWeek, 2)
+}
+
+func TestTflClient_GetCrowdingForDay(t *testing.T) {
+
+	got, err := client.GetCrowdingForDay("940GZZLUVIC", time.Monday)
+	assert.NoError(t, err)
+	assert.True(t, got.IsFound)
+	assert.Equal(t, "MON", got.DayOfWeek)
+	assert.Equal(t, CrowdingTimeBand{TimeBand: "07:45-08:00", PercentageOfBaseLine: 1.0}, got.TimeBands[3])
+}
+
+func TestTflClient_GetLiveCrowding(t *testing.T) {
+
+	got, err := client.GetLiveCrowding("940GZZLUVIC")
+	assert.NoError(t, err)
+	assert.Equal(t, &LiveCrowding{
+		DataAvailable:        true,
+		PercentageOfBaseline: 0.42,
+		TimeUTC:              "2020-08-22T15:10:00Z",
+		TimeLocal:            "2020-08-22 16:10:00",
+	}, got)
+}
+
+func TestCrowdingDay_QuietestWindow(t *testing.T) {
+
+	day := StationCrowdingDay{}
+	json.Unmarshal(getTestDataFileContents("crowding_day.json"), &day)
+
+	hours := func(h, m int) time.Duration {
+		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+	}
+
+	tests := []struct {
+		name      string
+		from, to  time.Duration
+		length    time.Duration
+		want      CrowdingWindow
+		wantFound bool
+	}{
+		{
+			name:      "Should find the quietest band",
+			from:      hours(7, 0),
+			to:        hours(10, 0),
+			length:    15 * time.Minute,
+			want:      CrowdingWindow{Start: hours(7, 0), End: hours(7, 15), PercentageOfBaseLine: 0.3},
+			wantFound: true,
+		},
+		{
+			name:      "Should find the quietest window spanning several bands",
+			from:      hours(7, 15),
+			to:        hours(10, 0),
+			length:    30 * time.Minute,
+			want:      CrowdingWindow{Start: hours(9, 0), End: hours(9, 30), PercentageOfBaseLine: 0.31},
+			wantFound: true,
+		},
+		{
+			name:      "Should find nothing when the window does not fit in the range",
+			from:      hours(7, 0),
+			to:        hours(7, 15),
+			length:    time.Hour,
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := day.QuietestWindow(tt.from, tt.to, tt.length)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.want.Start, got.Start)
+			assert.Equal(t, tt.want.End, got.End)
+			assert.InDelta(t, tt.want.PercentageOfBaseLine, got.PercentageOfBaseLine, 0.0001)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package tfl
+
+import "fmt"
+
+// CyclePreference is how the journey planner should use a bike for a journey
+type CyclePreference string
+
+// Cycle preferences supported by the journey planner
+const (
+	CycleAllTheWay       CyclePreference = "AllTheWay"
+	CycleLeaveAtStation  CyclePreference = "LeaveAtStation"
+	CycleTakeOnTransport CyclePreference = "TakeOnTransport"
+	CycleHire            CyclePreference = "CycleHire"
+)
+
+// BikeProficiency is the cyclist's speed, used by the journey planner to time cycle legs
+type BikeProficiency string
+
+// Bike proficiencies supported by the journey planner
+const (
+	BikeProficiencyEasy     BikeProficiency = "Easy"
+	BikeProficiencyModerate BikeProficiency = "Moderate"
+	BikeProficiencyFast     BikeProficiency = "Fast"
+)
+
+// validate checks the preference is one the journey planner supports, an empty preference is left to the API default
+func (p CyclePreference) validate() error {
+	switch p {
+	case "", CycleAllTheWay, CycleLeaveAtStation, CycleTakeOnTransport, CycleHire:
+		return nil
+	}
+	return fmt.Errorf("invalid cycle preference %q, must be one of AllTheWay, LeaveAtStation, TakeOnTransport or CycleHire", string(p))
+}
+
+// validate checks the proficiency is one the journey planner supports, an empty proficiency is left to the API default
+func (p BikeProficiency) validate() error {
+	switch p {
+	case "", BikeProficiencyEasy, BikeProficiencyModerate, BikeProficiencyFast:
+		return nil
+	}
+	return fmt.Errorf("invalid bike proficiency %q, must be one of Easy, Moderate or Fast", string(p))
+}
+
+// ElevationPoint is the height of a leg relative to its start at a distance along it
+type ElevationPoint struct {
+	Coordinate Coordinate
+	Distance   int
+	Height     int
+}
+
+// Hill is a continuous climb along a leg that is at least as steep as the gradient asked for
+type Hill struct {
+	Start       Coordinate
+	End         Coordinate
+	Distance    int
+	Climb       int
+	MaxGradient float64
+}
+
+// HillWarning is a hill on one of the legs of a journey
+type HillWarning struct {
+	LegIndex int
+	Hill     Hill
+}
+
+// IsCycle reports whether the leg is ridden on a bike, either the rider's own or a hire bike
+func (l Leg) IsCycle() bool {
+	return l.Mode.ID == "cycle" || l.Mode.ID == "cycle-hire"
+}
+
+// Coordinates decodes the path's line string into its ordered coordinates
+func (p LegPath) Coordinates() ([]Coordinate, error) {
+	return parseLatLonPairs([]byte(p.LineString))
+}
+
+// ElevationProfile returns the cumulative distance and height at the start of the leg and the end of each stretch
+func (l Leg) ElevationProfile() []ElevationPoint {
+
+	profile := []ElevationPoint{}
+	if len(l.Path.Elevation) == 0 {
+		return profile
+	}
+
+	first := l.Path.Elevation[0]
+	point := ElevationPoint{Coordinate: Coordinate{Lat: first.StartLat, Lon: first.StartLon}}
+	profile = append(profile, point)
+	for _, stretch := range l.Path.Elevation {
+		point = ElevationPoint{
+			Coordinate: Coordinate{Lat: stretch.EndLat, Lon: stretch.EndLon},
+			Distance:   point.Distance + stretch.Distance,
+			Height:     point.Height + stretch.HeightFromPreviousPoint,
+		}
+		profile = append(profile, point)
+	}
+	return profile
+}
+
+// Hills returns the climbs along the leg where consecutive stretches are at least minGradient steep
+// Gradients are the rise over the distance travelled, e.g. 0.05 for 5%
+func (l Leg) Hills(minGradient float64) []Hill {
+
+	hills := []Hill{}
+	climbing := false
+	for _, stretch := range l.Path.Elevation {
+		if stretch.HeightFromPreviousPoint <= 0 || stretch.Gradient < minGradient {
+			climbing = false
+			continue
+		}
+
+		if !climbing {
+			hills = append(hills, Hill{Start: Coordinate{Lat: stretch.StartLat, Lon: stretch.StartLon}})
+			climbing = true
+		}
+		hill := &hills[len(hills)-1]
+		hill.End = Coordinate{Lat: stretch.EndLat, Lon: stretch.EndLon}
+		hill.Distance += stretch.Distance
+		hill.Climb += stretch.HeightFromPreviousPoint
+		if stretch.Gradient > hill.MaxGradient {
+			hill.MaxGradient = stretch.Gradient
+		}
+	}
+	return hills
+}
+
+// HillWarnings returns the hills at least minGradient steep on the cycle legs of the journey
+func (j JourneyPlannerJourney) HillWarnings(minGradient float64) []HillWarning {
+
+	warnings := []HillWarning{}
+	for i, leg := range j.Legs {
+		if !leg.IsCycle() {
+			continue
+		}
+		for _, hill := range leg.Hills(minGradient) {
+			warnings = append(warnings, HillWarning{LegIndex: i, Hill: hill})
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,98 @@
+package tfl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func cycleQuery() JourneyPlannerQuery {
+	return JourneyPlannerQuery{
+		From:            "940GZZLUKSX",
+		To:              "940GZZLUCHX",
+		Date:            "20200822",
+		Time:            "0900",
+		Modes:           []string{"cycle-hire", "walking"},
+		CyclePreference: CycleHire,
+		BikeProficiency: BikeProficiencyModerate,
+	}
+}
+
+func TestTflClient_GetJourneyPlannerItinerary_cycling(t *testing.T) {
+
+	got, err := client.GetJourneyPlannerItinerary(cycleQuery())
+	assert.NoError(t, err)
+
+	legs := got.Journeys[0].Legs
+	assert.False(t, legs[0].IsCycle())
+	assert.True(t, legs[1].IsCycle())
+	assert.Equal(t, 3120.0, legs[1].Distance)
+
+	path, err := legs[1].Path.Coordinates()
+	assert.NoError(t, err)
+	assert.Equal(t, []Coordinate{
+		{Lat: 51.5298, Lon: -0.1240},
+		{Lat: 51.5286, Lon: -0.1244},
+		{Lat: 51.5265, Lon: -0.1250},
+		{Lat: 51.5073, Lon: -0.1276},
+	}, path)
+
+	profile := legs[1].ElevationProfile()
+	assert.Len(t, profile, 6)
+	assert.Equal(t, ElevationPoint{Coordinate: Coordinate{Lat: 51.5308, Lon: -0.1238}}, profile[0])
+	assert.Equal(t, ElevationPoint{Coordinate: Coordinate{Lat: 51.5265, Lon: -0.1250}, Distance: 500, Height: 15}, profile[5])
+	assert.Empty(t, legs[0].ElevationProfile())
+}
+
+func TestJourneyPlannerJourney_HillWarnings(t *testing.T) {
+
+	got, err := client.GetJourneyPlannerItinerary(cycleQuery())
+	assert.NoError(t, err)
+	journey := got.Journeys[0]
+
+	assert.Equal(t, []HillWarning{
+		{LegIndex: 1, Hill: Hill{
+			Start:       Coordinate{Lat: 51.5298, Lon: -0.1240},
+			End:         Coordinate{Lat: 51.5286, Lon: -0.1244},
+			Distance:    140,
+			Climb:       9,
+			MaxGradient: 0.0833,
+		}},
+		{LegIndex: 1, Hill: Hill{
+			Start:       Coordinate{Lat: 51.5273, Lon: -0.1247},
+			End:         Coordinate{Lat: 51.5265, Lon: -0.1250},
+			Distance:    90,
+			Climb:       6,
+			MaxGradient: 0.0667,
+		}},
+	}, journey.HillWarnings(0.05))
+
+	assert.Len(t, journey.HillWarnings(0.07), 1)
+	assert.Len(t, journey.HillWarnings(0.005), 1)
+}
+
+func TestTflClient_GetJourneyPlannerItinerary_invalidCycling(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   JourneyPlannerQuery
+		wantErr string
+	}{
+		{
+			name:    "Should reject an unknown cycle preference",
+			query:   JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUCHX", CyclePreference: "Tandem"},
+			wantErr: `invalid cycle preference "Tandem", must be one of AllTheWay, LeaveAtStation, TakeOnTransport or CycleHire`,
+		},
+		{
+			name:    "Should reject an unknown bike proficiency",
+			query:   JourneyPlannerQuery{From: "940GZZLUKSX", To: "940GZZLUCHX", BikeProficiency: "Slow"},
+			wantErr: `invalid bike proficiency "Slow", must be one of Easy, Moderate or Fast`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetJourneyPlannerItinerary(tt.query)
+			assert.Nil(t, got)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
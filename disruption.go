@@ -0,0 +1,254 @@
+package tfl
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DisruptionEventType describes how a disruption changed between two polls
+type DisruptionEventType string
+
+// The changes a DisruptionPoller emits
+const (
+	DisruptionNew      DisruptionEventType = "new"
+	DisruptionChanged  DisruptionEventType = "changed"
+	DisruptionResolved DisruptionEventType = "resolved"
+)
+
+// DisruptionEvent is a change to a line or stop point disruption
+// Exactly one of LineDisruption and StopPointDisruption is set
+type DisruptionEvent struct {
+	Type                DisruptionEventType
+	ID                  string
+	LineDisruption      *Disruption
+	StopPointDisruption *DisruptedPoint
+}
+
+// DisruptionPoller fetches the line and stop point disruptions for modes and diffs them against the previous fetch
+type DisruptionPoller struct {
+	client   *TflClient
+	modes    []string
+	interval time.Duration
+	seen     map[string]seenDisruption
+}
+
+// seenDisruption is the last version of a disruption the poller fetched
+type seenDisruption struct {
+	version string
+	event   DisruptionEvent
+}
+
+// ID returns an identifier for the disruption, which the API does not provide
+// It is made from the category, type, creation time and what the disruption affects,
+// or the description when it affects no particular routes or stops
+func (d Disruption) ID() string {
+	parts := []string{d.Category, d.Type, d.Created}
+	affected := []string{}
+	for _, route := range d.AffectedRoutes {
+		affected = append(affected, route.ID)
+	}
+	for _, stop := range d.AffectedStops {
+		affected = append(affected, stop.NaptanID)
+	}
+	sort.Strings(affected)
+	if len(affected) == 0 {
+		parts = append(parts, d.Description)
+	}
+	return strings.Join(append(parts, affected...), "|")
+}
+
+// ID returns an identifier for the disrupted point, which the API does not provide
+func (d DisruptedPoint) ID() string {
+	return strings.Join([]string{d.AtcoCode, d.Type, d.FromDate}, "|")
+}
+
+func (d Disruption) version() string {
+	if d.LastUpdate != "" {
+		return d.LastUpdate
+	}
+	return d.Description
+}
+
+func (d DisruptedPoint) version() string {
+	return strings.Join([]string{d.ToDate, d.Appearance, d.Description}, "|")
+}
+
+// GetLineDisruptionsByMode retrieves the disruptions on lines of the given modes
+// It queries the endpoint /Line/Mode/{modes}/Disruption
+func (c *TflClient) GetLineDisruptionsByMode(modes []string) (*[]Disruption, error) {
+	return c.getLineDisruptionsByMode(context.Background(), modes)
+}
+
+func (c *TflClient) getLineDisruptionsByMode(ctx context.Context, modes []string) (*[]Disruption, error) {
+
+	pathParams := []string{linePath, modePath, strings.Join(modes, ","), disruptionPath}
+	url := c.buildURL(pathParams)
+
+	resp := []Disruption{}
+	if err := c.getJSONContext(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetStopPointDisruptionsByMode retrieves the disruptions at stop points of the given modes
+// It queries the endpoint /StopPoint/Mode/{modes}/Disruption
+func (c *TflClient) GetStopPointDisruptionsByMode(modes []string) (*[]DisruptedPoint, error) {
+	return c.getStopPointDisruptionsByMode(context.Background(), modes)
+}
+
+func (c *TflClient) getStopPointDisruptionsByMode(ctx context.Context, modes []string) (*[]DisruptedPoint, error) {
+
+	pathParams := []string{stopPointPath, modePath, strings.Join(modes, ","), disruptionPath}
+	url := c.buildURL(pathParams)
+
+	resp := []DisruptedPoint{}
+	if err := c.getJSONContext(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// NewDisruptionPoller returns a DisruptionPoller for the modes which fetches on the interval when run
+func (c *TflClient) NewDisruptionPoller(modes []string, interval time.Duration) (*DisruptionPoller, error) {
+	if interval <= 0 {
+		return nil, errors.New("disruption poll interval must be positive")
+	}
+	return &DisruptionPoller{
+		client:   c,
+		modes:    modes,
+		interval: interval,
+		seen:     map[string]seenDisruption{},
+	}, nil
+}
+
+// Poll fetches the disruptions once and returns what is new, changed or resolved since the previous Poll
+// Every disruption is new on the first Poll
+func (p *DisruptionPoller) Poll() ([]DisruptionEvent, error) {
+	return p.PollContext(context.Background())
+}
+
+// PollContext is Poll with a context that cancels the requests
+func (p *DisruptionPoller) PollContext(ctx context.Context) ([]DisruptionEvent, error) {
+
+	lineDisruptions, err := p.client.getLineDisruptionsByMode(ctx, p.modes)
+	if err != nil {
+		return nil, err
+	}
+	stopPointDisruptions, err := p.client.getStopPointDisruptionsByMode(ctx, p.modes)
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string]seenDisruption{}
+	order := []string{}
+	add := func(id string, disruption seenDisruption) {
+		id = uniqueDisruptionID(current, id)
+		disruption.event.ID = id
+		current[id] = disruption
+		order = append(order, id)
+	}
+	for i := range *lineDisruptions {
+		disruption := &(*lineDisruptions)[i]
+		add("line|"+disruption.ID(), seenDisruption{
+			version: disruption.version(),
+			event:   DisruptionEvent{LineDisruption: disruption},
+		})
+	}
+	for i := range *stopPointDisruptions {
+		disruption := &(*stopPointDisruptions)[i]
+		add("stopPoint|"+disruption.ID(), seenDisruption{
+			version: disruption.version(),
+			event:   DisruptionEvent{StopPointDisruption: disruption},
+		})
+	}
+
+	events := []DisruptionEvent{}
+	for _, id := range order {
+		disruption := current[id]
+		previous, ok := p.seen[id]
+		if !ok {
+			disruption.event.Type = DisruptionNew
+			events = append(events, disruption.event)
+		} else if previous.version != disruption.version {
+			disruption.event.Type = DisruptionChanged
+			events = append(events, disruption.event)
+		}
+	}
+
+	resolved := []string{}
+	for id := range p.seen {
+		if _, ok := current[id]; !ok {
+			resolved = append(resolved, id)
+		}
+	}
+	sort.Strings(resolved)
+	for _, id := range resolved {
+		event := p.seen[id].event
+		event.Type = DisruptionResolved
+		events = append(events, event)
+	}
+
+	p.seen = current
+	return events, nil
+}
+
+// uniqueDisruptionID suffixes the ID with a counter when a disruption already fetched has it
+// The API can return indistinguishable disruptions, these keep their IDs while they are returned in the same order
+func uniqueDisruptionID(current map[string]seenDisruption, id string) string {
+	unique := id
+	for n := 2; ; n++ {
+		if _, ok := current[unique]; !ok {
+			return unique
+		}
+		unique = id + "#" + strconv.Itoa(n)
+	}
+}
+
+// Run polls immediately and then on the poller's interval until the context is cancelled
+// Events are sent on the first channel and the latest failed poll is kept on the second until read,
+// it need not be read for events to keep coming. Both are closed when Run stops
+func (p *DisruptionPoller) Run(ctx context.Context) (<-chan DisruptionEvent, <-chan error) {
+
+	events := make(chan DisruptionEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			polled, err := p.PollContext(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				sendLatestError(errs, err)
+			}
+			for _, event := range polled {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, errs
+}
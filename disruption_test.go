@@ -0,0 +1,227 @@
+package tfl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetLineDisruptionsByMode(t *testing.T) {
+
+	expected := []Disruption{}
+	json.Unmarshal(getTestDataFileContents("line_disruptions.json"), &expected)
+
+	got, err := client.GetLineDisruptionsByMode([]string{"tube"})
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestTflClient_GetStopPointDisruptionsByMode(t *testing.T) {
+
+	expected := []DisruptedPoint{}
+	json.Unmarshal(getTestDataFileContents("stop_point_disruptions.json"), &expected)
+
+	got, err := client.GetStopPointDisruptionsByMode([]string{"tube"})
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+// disruptionFeedStub serves a different set of line disruptions on each poll
+func disruptionFeedStub(t *testing.T, rounds [][]Disruption) (*TflClient, func()) {
+	var round int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/StopPoint/") {
+			w.Write([]byte("[]"))
+			return
+		}
+		i := int(atomic.AddInt32(&round, 1)) - 1
+		if i >= len(rounds) {
+			i = len(rounds) - 1
+		}
+		body, _ := json.Marshal(rounds[i])
+		w.Write(body)
+	}))
+
+	stubClient, err := New(WithBaseURL(stub.URL))
+	assert.NoError(t, err)
+	return stubClient, stub.Close
+}
+
+func TestDisruptionPoller_Poll(t *testing.T) {
+
+	northern := Disruption{Category: "RealTime", Type: "lineInfo", Created: "2020-08-22T15:30:00Z", LastUpdate: "2020-08-22T15:30:00Z"}
+	northernUpdated := northern
+	northernUpdated.LastUpdate = "2020-08-22T15:45:00Z"
+	central := Disruption{Category: "PlannedWork", Type: "lineInfo", Created: "2020-08-20T09:00:00Z", LastUpdate: "2020-08-20T09:00:00Z"}
+
+	stubClient, teardown := disruptionFeedStub(t, [][]Disruption{
+		{northern},
+		{northern},
+		{northernUpdated, central},
+		{central},
+	})
+	defer teardown()
+
+	poller, err := stubClient.NewDisruptionPoller([]string{"tube"}, time.Minute)
+	assert.NoError(t, err)
+
+	wantTypes := [][]DisruptionEventType{
+		{DisruptionNew},
+		{},
+		{DisruptionChanged, DisruptionNew},
+		{DisruptionResolved},
+	}
+	for _, want := range wantTypes {
+		events, err := poller.Poll()
+		assert.NoError(t, err)
+		got := []DisruptionEventType{}
+		for _, event := range events {
+			got = append(got, event.Type)
+		}
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestDisruptionPoller_Poll_indistinguishable(t *testing.T) {
+
+	// Mode wide line info shares category, type and creation time and affects no routes or stops
+	closures := Disruption{Category: "Information", Type: "lineInfo", Created: "2020-08-22T06:00:00Z", Description: "Station closures this weekend"}
+	lifts := closures
+	lifts.Description = "Lift outages at several stations"
+	duplicate := closures
+
+	stubClient, teardown := disruptionFeedStub(t, [][]Disruption{
+		{closures, lifts, duplicate},
+		{lifts},
+	})
+	defer teardown()
+	poller, err := stubClient.NewDisruptionPoller([]string{"tube"}, time.Minute)
+	assert.NoError(t, err)
+
+	events, err := poller.Poll()
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	descriptions := map[string]string{}
+	for _, event := range events {
+		assert.Equal(t, DisruptionNew, event.Type)
+		descriptions[event.ID] = event.LineDisruption.Description
+	}
+	assert.Equal(t, map[string]string{
+		"line|" + closures.ID():        "Station closures this weekend",
+		"line|" + lifts.ID():           "Lift outages at several stations",
+		"line|" + closures.ID() + "#2": "Station closures this weekend",
+	}, descriptions)
+
+	events, err = poller.Poll()
+	assert.NoError(t, err)
+	resolved := []string{}
+	for _, event := range events {
+		assert.Equal(t, DisruptionResolved, event.Type)
+		resolved = append(resolved, event.ID)
+	}
+	assert.Equal(t, []string{"line|" + closures.ID(), "line|" + closures.ID() + "#2"}, resolved)
+}
+
+func TestDisruptionPoller_Run(t *testing.T) {
+
+	northern := Disruption{Category: "RealTime", Type: "lineInfo", Created: "2020-08-22T15:30:00Z"}
+	stubClient, teardown := disruptionFeedStub(t, [][]Disruption{{northern}, {}})
+	defer teardown()
+
+	poller, err := stubClient.NewDisruptionPoller([]string{"tube"}, time.Millisecond)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := poller.Run(ctx)
+
+	assert.Equal(t, DisruptionNew, (<-events).Type)
+	resolved := <-events
+	assert.Equal(t, DisruptionResolved, resolved.Type)
+	assert.Equal(t, "line|"+northern.ID(), resolved.ID)
+
+	cancel()
+	for range events {
+	}
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestDisruptionPoller_Run_failedPollUnread(t *testing.T) {
+
+	northern := Disruption{Category: "RealTime", Type: "lineInfo", Created: "2020-08-22T15:30:00Z"}
+	var requests int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/StopPoint/") {
+			w.Write([]byte("[]"))
+			return
+		}
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"Internal Server Error"}`))
+			return
+		}
+		body, _ := json.Marshal([]Disruption{northern})
+		w.Write(body)
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	poller, err := stubClient.NewDisruptionPoller([]string{"tube"}, time.Millisecond)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := poller.Run(ctx)
+
+	// Only events are read, the failed polls must not hold them up
+	select {
+	case event := <-events:
+		assert.Equal(t, DisruptionNew, event.Type)
+	case <-time.After(time.Second):
+		t.Fatal("events stopped after a failed poll that was not read")
+	}
+	assert.EqualError(t, <-errs, "Internal Server Error", "latest error should be kept")
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestDisruptionPoller_Run_cancelDuringPoll(t *testing.T) {
+
+	requested := make(chan struct{}, 1)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	poller, err := stubClient.NewDisruptionPoller([]string{"tube"}, time.Minute)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := poller.Run(ctx)
+	<-requested
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop while a poll was in flight")
+	}
+	for range errs {
+	}
+}
+
+func TestTflClient_NewDisruptionPoller_invalidInterval(t *testing.T) {
+	_, err := client.NewDisruptionPoller([]string{"tube"}, 0)
+	assert.EqualError(t, err, "disruption poll interval must be positive")
+}
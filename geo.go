@@ -0,0 +1,85 @@
+package tfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// earthRadiusMetres is the mean radius of the Earth used for distance calculations
+const earthRadiusMetres float64 = 6371000
+
+// Coordinate is a WGS84 latitude and longitude
+type Coordinate struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// DistanceTo returns the great circle distance in metres between two coordinates
+func (c Coordinate) DistanceTo(other Coordinate) float64 {
+	lat1 := c.Lat * math.Pi / 180
+	lat2 := other.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Lon - c.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMetres * math.Asin(math.Sqrt(h))
+}
+
+// parseLonLatPairs parses a JSON encoded string or array of [lon, lat] pairs, nested to any depth, into coordinates
+// The API encodes bounds, points and geometries this way, e.g. "[[-0.25616,51.5319],[-0.10234,51.5401]]"
+func parseLonLatPairs(data []byte) ([]Coordinate, error) {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		data = []byte(encoded)
+	}
+	if len(data) == 0 {
+		return []Coordinate{}, nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	coordinates := []Coordinate{}
+	if err := collectLonLatPairs(decoded, &coordinates); err != nil {
+		return nil, err
+	}
+	return coordinates, nil
+}
+
+func collectLonLatPairs(value interface{}, coordinates *[]Coordinate) error {
+	values, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected coordinate value %v", value)
+	}
+	if len(values) == 2 {
+		lon, lonOK := values[0].(float64)
+		lat, latOK := values[1].(float64)
+		if lonOK && latOK {
+			*coordinates = append(*coordinates, Coordinate{Lat: lat, Lon: lon})
+			return nil
+		}
+	}
+	for _, nested := range values {
+		if err := collectLonLatPairs(nested, coordinates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseLatLonPairs parses a JSON encoded string of [lat, lon] pairs into coordinates
+// The journey planner encodes leg paths this way, the reverse of the other endpoints
+func parseLatLonPairs(data []byte) ([]Coordinate, error) {
+	coordinates, err := parseLonLatPairs(data)
+	if err != nil {
+		return nil, err
+	}
+	for i, coordinate := range coordinates {
+		coordinates[i] = Coordinate{Lat: coordinate.Lon, Lon: coordinate.Lat}
+	}
+	return coordinates, nil
+}
@@ -0,0 +1,88 @@
+package tfl
+
+import "time"
+
+// HopperLeg is a bus or tram leg of a journey and whether the Hopper fare makes it free
+type HopperLeg struct {
+	Index             int
+	Leg               Leg
+	MinutesSinceFirst int
+	Free              bool
+}
+
+// HopperResult holds the Hopper fare breakdown for a journey
+type HopperResult struct {
+	Legs          []HopperLeg
+	ChargedLegs   int
+	FreeLegs      int
+	OriginalTotal uint16
+	AdjustedTotal uint16
+}
+
+// HopperFareForJourney works out which bus and tram legs of a journey fall within the Hopper window
+// A leg departing within 60 minutes of the first charged bus or tram leg is free, later legs open a new window
+// The adjusted total is the cost of the journey's non bus fares plus busFare for every charged leg
+// Fares without taps cannot be matched to a leg, they are only taken to be bus fares when marked as Hopper fares
+// or when every leg that is paid for is a bus or tram leg
+func HopperFareForJourney(journey JourneyPlannerJourney, busFare uint16) (*HopperResult, error) {
+
+	result := &HopperResult{
+		Legs:          []HopperLeg{},
+		OriginalTotal: journey.Fare.TotalCost,
+	}
+
+	var windowStart time.Time
+	windowOpen := false
+	for i, leg := range journey.Legs {
+		if !isHopperMode(leg.Mode.ID) {
+			continue
+		}
+		departure, err := parseTflTime(leg.DepartureTime)
+		if err != nil {
+			return nil, err
+		}
+
+		hopperLeg := HopperLeg{Index: i, Leg: leg}
+		if windowOpen {
+			if elapsed := departure.Sub(windowStart); elapsed <= hopperWindow {
+				hopperLeg.MinutesSinceFirst = int(elapsed.Minutes())
+				hopperLeg.Free = true
+			}
+		}
+
+		if hopperLeg.Free {
+			result.FreeLegs++
+		} else {
+			result.ChargedLegs++
+			windowStart = departure
+			windowOpen = true
+		}
+		result.Legs = append(result.Legs, hopperLeg)
+	}
+
+	adjusted := uint16(result.ChargedLegs) * busFare
+	onlyHopperLegs := len(result.Legs) > 0 && len(result.Legs) == paidLegs(journey)
+	for _, fare := range journey.Fare.Fares {
+		if len(fare.Taps) == 0 && (fare.IsHopperFare || onlyHopperLegs) {
+			continue
+		}
+		if len(fare.Taps) > 0 && isHopperMode(fare.Taps[0].TapDetails.ModeType) {
+			continue
+		}
+		adjusted += fare.Cost
+	}
+	result.AdjustedTotal = adjusted
+
+	return result, nil
+}
+
+// paidLegs returns how many legs of the journey are not walked
+func paidLegs(journey JourneyPlannerJourney) int {
+	paid := 0
+	for _, leg := range journey.Legs {
+		if leg.Mode.ID != "walking" {
+			paid++
+		}
+	}
+	return paid
+}
@@ -0,0 +1,152 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func busLeg(departureTime string) Leg {
+	return Leg{DepartureTime: departureTime, Mode: LineIdentifier{ID: "bus"}}
+}
+
+func TestHopperFareForJourney(t *testing.T) {
+
+	itinerary := JourneyPlannerItineraryResult{}
+	json.Unmarshal(getTestDataFileContents("Should_retrieve_journey_planner_itinerary_for_valid_search.json"), &itinerary)
+
+	type args struct {
+		journey JourneyPlannerJourney
+		busFare uint16
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantFree    []bool
+		wantTotal   uint16
+		wantCharged int
+	}{
+		{
+			name: "Should make the second bus within the hopper window free",
+			args: args{
+				journey: JourneyPlannerJourney{
+					Legs: []Leg{
+						busLeg("2020-08-17T08:00:00"),
+						{DepartureTime: "2020-08-17T08:20:00", Mode: LineIdentifier{ID: "walking"}},
+						busLeg("2020-08-17T08:45:00"),
+					},
+					Fare: JourneyFare{TotalCost: 300},
+				},
+				busFare: 150,
+			},
+			wantFree:    []bool{false, true},
+			wantTotal:   150,
+			wantCharged: 1,
+		},
+		{
+			name: "Should charge a bus leg outside the hopper window",
+			args: args{
+				journey: JourneyPlannerJourney{
+					Legs: []Leg{
+						busLeg("2020-08-17T08:00:00"),
+						busLeg("2020-08-17T08:40:00"),
+						busLeg("2020-08-17T09:05:00"),
+						busLeg("2020-08-17T09:30:00"),
+					},
+				},
+				busFare: 150,
+			},
+			wantFree:    []bool{false, true, false, true},
+			wantTotal:   300,
+			wantCharged: 2,
+		},
+		{
+			name: "Should replace fares without taps when every paid leg is a bus",
+			args: args{
+				journey: JourneyPlannerJourney{
+					Legs: []Leg{
+						busLeg("2020-08-17T08:00:00"),
+						{DepartureTime: "2020-08-17T08:20:00", Mode: LineIdentifier{ID: "walking"}},
+						busLeg("2020-08-17T08:50:00"),
+					},
+					Fare: JourneyFare{TotalCost: 300, Fares: []Fare{{Cost: 150}, {Cost: 150}}},
+				},
+				busFare: 150,
+			},
+			wantFree:    []bool{false, true},
+			wantTotal:   150,
+			wantCharged: 1,
+		},
+		{
+			name: "Should keep a tapped rail fare alongside bus legs",
+			args: args{
+				journey: JourneyPlannerJourney{
+					Legs: []Leg{
+						busLeg("2020-08-17T08:00:00"),
+						{DepartureTime: "2020-08-17T08:20:00", Mode: LineIdentifier{ID: "tube"}},
+						busLeg("2020-08-17T08:50:00"),
+					},
+					Fare: JourneyFare{
+						TotalCost: 580,
+						Fares: []Fare{
+							{Cost: 150, IsHopperFare: true},
+							{Cost: 280, Taps: []FareTap{{TapDetails: FareTapDetails{ModeType: "Underground"}}}},
+							{Cost: 150, Taps: []FareTap{{TapDetails: FareTapDetails{ModeType: "Bus"}}}},
+						},
+					},
+				},
+				busFare: 150,
+			},
+			wantFree:    []bool{false, true},
+			wantTotal:   430,
+			wantCharged: 1,
+		},
+		{
+			name: "Should keep an untapped rail fare alongside bus legs",
+			args: args{
+				journey: JourneyPlannerJourney{
+					Legs: []Leg{
+						busLeg("2020-08-17T08:00:00"),
+						{DepartureTime: "2020-08-17T08:20:00", Mode: LineIdentifier{ID: "tube"}},
+						busLeg("2020-08-17T08:50:00"),
+					},
+					Fare: JourneyFare{
+						TotalCost: 430,
+						Fares: []Fare{
+							{Cost: 280},
+							{Cost: 150, Taps: []FareTap{{TapDetails: FareTapDetails{ModeType: "Bus"}}}},
+						},
+					},
+				},
+				busFare: 150,
+			},
+			wantFree:    []bool{false, true},
+			wantTotal:   430,
+			wantCharged: 1,
+		},
+		{
+			name: "Should keep rail fares for journeys without bus legs",
+			args: args{
+				journey: itinerary.Journeys[0],
+				busFare: 150,
+			},
+			wantFree:    []bool{},
+			wantTotal:   700,
+			wantCharged: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HopperFareForJourney(tt.args.journey, tt.args.busFare)
+			assert.NoError(t, err)
+			free := []bool{}
+			for _, leg := range got.Legs {
+				free = append(free, leg.Free)
+			}
+			assert.Equal(t, tt.wantFree, free)
+			assert.Equal(t, tt.wantTotal, got.AdjustedTotal)
+			assert.Equal(t, tt.wantCharged, got.ChargedLegs)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package tfl
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+)
+
+// metresPerDegreeLat is the approximate length of a degree of latitude
+const metresPerDegreeLat float64 = 111320
+
+// DefaultIsochroneMinutes are the travel times isochrones are drawn for when none are given
+var DefaultIsochroneMinutes = []int{15, 30, 45, 60}
+
+// TravelTimeQuery asks for the travel time from an origin to each destination
+// Query sets the date, time, modes and other options of every journey, its From and To are replaced
+type TravelTimeQuery struct {
+	Origin       Coordinate
+	Destinations []Coordinate
+	Query        JourneyPlannerQuery
+}
+
+// TravelTime is the quickest journey time to a destination
+// Destinations that could not be reached, or whose journey failed to plan, are not Reachable
+type TravelTime struct {
+	Destination Coordinate
+	Minutes     int
+	Reachable   bool
+	Err         error
+}
+
+// TravelTimeMatrix holds the travel times from the origin in the order of the destinations
+type TravelTimeMatrix struct {
+	Origin  Coordinate
+	Entries []TravelTime
+}
+
+// GeoJSONFeatureCollection represents a GeoJSON FeatureCollection of polygons
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// GeoJSONFeature represents a GeoJSON Feature with a polygon geometry
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   GeoJSONPolygon         `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GeoJSONPolygon represents a GeoJSON Polygon, each ring a closed list of [lon, lat] positions
+type GeoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// GridDestinations returns the points of a square grid spacing metres apart that lie within radius metres of the centre
+func GridDestinations(centre Coordinate, radius, spacing float64) ([]Coordinate, error) {
+
+	if radius <= 0 || spacing <= 0 {
+		return nil, errors.New("grid radius and spacing must be positive")
+	}
+
+	latStep := spacing / metresPerDegreeLat
+	lonStep := spacing / (metresPerDegreeLat * math.Cos(centre.Lat*math.Pi/180))
+	steps := int(radius / spacing)
+
+	destinations := []Coordinate{}
+	for i := -steps; i <= steps; i++ {
+		for j := -steps; j <= steps; j++ {
+			point := Coordinate{Lat: centre.Lat + float64(i)*latStep, Lon: centre.Lon + float64(j)*lonStep}
+			if centre.DistanceTo(point) <= radius {
+				destinations = append(destinations, point)
+			}
+		}
+	}
+	return destinations, nil
+}
+
+// GetTravelTimeMatrix plans a journey from the origin to every destination through the batch planner
+// If the context is cancelled the travel times so far are returned along with the context's error
+// It queries the endpoint /Journey/JourneyResult/{from}/to/{to} for each destination
+func (c *TflClient) GetTravelTimeMatrix(ctx context.Context, query TravelTimeQuery, opts BatchOptions) (*TravelTimeMatrix, error) {
+
+	origin, err := NewCoordinateLocation(query.Origin)
+	if err != nil {
+		return nil, err
+	}
+	queries := []JourneyPlannerQuery{}
+	for _, destination := range query.Destinations {
+		to, err := NewCoordinateLocation(destination)
+		if err != nil {
+			return nil, err
+		}
+		planned := query.Query
+		planned.From = origin
+		planned.To = to
+		queries = append(queries, planned)
+	}
+
+	results, err := c.PlanJourneys(ctx, queries, opts)
+	if results == nil {
+		return nil, err
+	}
+
+	matrix := &TravelTimeMatrix{Origin: query.Origin, Entries: []TravelTime{}}
+	for i, result := range results {
+		entry := TravelTime{Destination: query.Destinations[i], Err: result.Err}
+		if result.Itinerary != nil {
+			for _, journey := range result.Itinerary.Journeys {
+				if !entry.Reachable || int(journey.Duration) < entry.Minutes {
+					entry.Minutes = int(journey.Duration)
+					entry.Reachable = true
+				}
+			}
+		}
+		matrix.Entries = append(matrix.Entries, entry)
+	}
+
+	return matrix, err
+}
+
+// Isochrones returns a polygon for each travel time enclosing the origin and the destinations reachable within it
+// Each polygon is the convex hull of those points, travel times whose points do not enclose an area are left out
+// The hull over-approximates the area: destinations inside it may take longer or be unreachable,
+// so check a destination's own entry in the matrix rather than whether it lies within a polygon
+// DefaultIsochroneMinutes are used when no travel times are given
+func (m TravelTimeMatrix) Isochrones(minutes []int) GeoJSONFeatureCollection {
+
+	if len(minutes) == 0 {
+		minutes = DefaultIsochroneMinutes
+	}
+
+	collection := GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []GeoJSONFeature{}}
+	for _, limit := range minutes {
+		points := []Coordinate{m.Origin}
+		for _, entry := range m.Entries {
+			if entry.Reachable && entry.Minutes <= limit {
+				points = append(points, entry.Destination)
+			}
+		}
+
+		hull := convexHull(points)
+		if len(hull) < 3 {
+			continue
+		}
+		ring := [][2]float64{}
+		for _, point := range append(hull, hull[0]) {
+			ring = append(ring, [2]float64{point.Lon, point.Lat})
+		}
+
+		collection.Features = append(collection.Features, GeoJSONFeature{
+			Type:       "Feature",
+			Geometry:   GeoJSONPolygon{Type: "Polygon", Coordinates: [][][2]float64{ring}},
+			Properties: map[string]interface{}{"minutes": limit},
+		})
+	}
+	return collection
+}
+
+// convexHull returns the points of the convex hull in counter-clockwise order using the monotone chain algorithm
+func convexHull(points []Coordinate) []Coordinate {
+
+	sorted := append([]Coordinate{}, points...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Lon != sorted[j].Lon {
+			return sorted[i].Lon < sorted[j].Lon
+		}
+		return sorted[i].Lat < sorted[j].Lat
+	})
+	if len(sorted) < 3 {
+		return sorted
+	}
+
+	cross := func(o, a, b Coordinate) float64 {
+		return (a.Lon-o.Lon)*(b.Lat-o.Lat) - (a.Lat-o.Lat)*(b.Lon-o.Lon)
+	}
+
+	hull := []Coordinate{}
+	for _, point := range sorted {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], point) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, point)
+	}
+	lower := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], sorted[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, sorted[i])
+	}
+	return hull[:len(hull)-1]
+}
@@ -0,0 +1,179 @@
+package tfl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// isochroneStub plans journeys taking a minute for every 100 metres from the origin
+// and counts the requests that reach it
+func isochroneStub(t *testing.T) (*TflClient, *int) {
+
+	var mu sync.Mutex
+	requests := 0
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/"+journeyResultsPath+"/"), "/")
+		from, to := parseTestCoordinate(path[0]), parseTestCoordinate(path[2])
+		minutes := int(from.DistanceTo(to) / 100)
+		w.Write([]byte(fmt.Sprintf(`{"journeys": [{"duration": %d}, {"duration": %d}]}`, minutes+5, minutes)))
+	}))
+	t.Cleanup(stub.Close)
+
+	stubClient, _ := New(WithBaseURL(stub.URL), WithCache(time.Minute, 1000))
+	return stubClient, &requests
+}
+
+func parseTestCoordinate(value string) Coordinate {
+	parts := strings.Split(value, ",")
+	lat, _ := strconv.ParseFloat(parts[0], 64)
+	lon, _ := strconv.ParseFloat(parts[1], 64)
+	return Coordinate{Lat: lat, Lon: lon}
+}
+
+func TestGridDestinations(t *testing.T) {
+
+	centre := Coordinate{Lat: 51.5055, Lon: -0.0754}
+	got, err := GridDestinations(centre, 2000, 1000)
+	assert.NoError(t, err)
+	assert.Len(t, got, 13)
+	for _, destination := range got {
+		assert.LessOrEqual(t, centre.DistanceTo(destination), 2000.0)
+	}
+
+	_, err = GridDestinations(centre, 2000, 0)
+	assert.EqualError(t, err, "grid radius and spacing must be positive")
+}
+
+func TestTflClient_GetTravelTimeMatrix(t *testing.T) {
+
+	stubClient, requests := isochroneStub(t)
+	origin := Coordinate{Lat: 51.5055, Lon: -0.0754}
+	destinations, _ := GridDestinations(origin, 4000, 1000)
+	query := TravelTimeQuery{
+		Origin:       origin,
+		Destinations: destinations,
+		Query:        JourneyPlannerQuery{Date: "20200824", Time: "0830"},
+	}
+
+	got, err := stubClient.GetTravelTimeMatrix(context.Background(), query, BatchOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, got.Entries, len(destinations))
+	for i, entry := range got.Entries {
+		assert.Equal(t, destinations[i], entry.Destination)
+		assert.True(t, entry.Reachable)
+		assert.Equal(t, int(origin.DistanceTo(destinations[i])/100), entry.Minutes)
+	}
+
+	_, err = stubClient.GetTravelTimeMatrix(context.Background(), query, BatchOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, len(destinations), *requests)
+
+	isochrones := got.Isochrones(nil)
+	assert.Equal(t, "FeatureCollection", isochrones.Type)
+	assert.Len(t, isochrones.Features, 4)
+	for i, feature := range isochrones.Features {
+		limit := DefaultIsochroneMinutes[i]
+		assert.Equal(t, limit, feature.Properties["minutes"])
+		assert.Equal(t, "Polygon", feature.Geometry.Type)
+
+		ring := feature.Geometry.Coordinates[0]
+		assert.Equal(t, ring[0], ring[len(ring)-1])
+		for _, position := range ring {
+			assert.LessOrEqual(t, origin.DistanceTo(Coordinate{Lat: position[1], Lon: position[0]}), float64(limit*100+100))
+		}
+	}
+}
+
+func TestTravelTimeMatrix_Isochrones_unreachableInsideHull(t *testing.T) {
+
+	origin := Coordinate{Lat: 0, Lon: 0}
+	matrix := TravelTimeMatrix{
+		Origin: origin,
+		Entries: []TravelTime{
+			{Destination: Coordinate{Lat: 0, Lon: 1}, Minutes: 10, Reachable: true},
+			{Destination: Coordinate{Lat: 1, Lon: 1}, Minutes: 12, Reachable: true},
+			{Destination: Coordinate{Lat: 1, Lon: 0}, Minutes: 14, Reachable: true},
+			{Destination: Coordinate{Lat: 0.5, Lon: 0.5}, Minutes: 50, Reachable: true},
+			{Destination: Coordinate{Lat: 0.25, Lon: 0.75}},
+		},
+	}
+
+	isochrones := matrix.Isochrones([]int{15})
+	assert.Len(t, isochrones.Features, 1)
+	// The hull of the reachable corners covers the slow and unreachable destinations inside it
+	assert.Equal(t, [][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}, isochrones.Features[0].Geometry.Coordinates[0])
+}
+
+func Test_convexHull(t *testing.T) {
+
+	got := convexHull([]Coordinate{
+		{Lat: 0, Lon: 0}, {Lat: 1, Lon: 1}, {Lat: 0, Lon: 2}, {Lat: 2, Lon: 2},
+		{Lat: 2, Lon: 0}, {Lat: 1, Lon: 0},
+	})
+	assert.Equal(t, []Coordinate{
+		{Lat: 0, Lon: 0}, {Lat: 0, Lon: 2}, {Lat: 2, Lon: 2}, {Lat: 2, Lon: 0},
+	}, got)
+}
+
+func TestWithCache(t *testing.T) {
+
+	requests := map[string]int{}
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		if r.URL.Path == "/AirQuality" {
+			w.Write(getTestDataFileContents("air_quality.json"))
+			return
+		}
+		w.Write([]byte(`{"journeys": [{"duration": 25}]}`))
+	}))
+	defer stub.Close()
+
+	stubClient, _ := New(WithBaseURL(stub.URL), WithCache(20*time.Millisecond, 2))
+	query := JourneyPlannerQuery{From: "1000173", To: "1001089", Date: "20200824", Time: "0830"}
+	for i := 0; i < 3; i++ {
+		got, err := stubClient.GetJourneyPlannerItinerary(query)
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(25), got.Journeys[0].Duration)
+
+		_, err = stubClient.GetAirQuality()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, requests["/Journey/JourneyResults/1000173/to/1001089"])
+	assert.Equal(t, 3, requests["/AirQuality"], "live endpoints should not be cached")
+
+	time.Sleep(30 * time.Millisecond)
+	_, err := stubClient.GetJourneyPlannerItinerary(query)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests["/Journey/JourneyResults/1000173/to/1001089"])
+
+	_, err = New(WithCache(0, 10))
+	assert.EqualError(t, err, "cache ttl and max entries must be positive")
+}
+
+func Test_responseCache_evictsOldest(t *testing.T) {
+
+	cache := newResponseCache(time.Minute, 2)
+	cache.set("a", []byte("1"))
+	cache.set("b", []byte("2"))
+	cache.set("c", []byte("3"))
+
+	_, ok := cache.get("a")
+	assert.False(t, ok)
+	body, ok := cache.get("c")
+	assert.True(t, ok)
+	assert.Equal(t, "3", string(body))
+	assert.Len(t, cache.entries, 2)
+}
@@ -0,0 +1,127 @@
+package tfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// arrayDecoder decodes the elements of a JSON array response one at a time
+// It is embedded by the exported iterators, which supply the element type
+type arrayDecoder struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+	opened  bool
+	done    bool
+}
+
+func newArrayDecoder(body io.ReadCloser) *arrayDecoder {
+	return &arrayDecoder{body: body, decoder: json.NewDecoder(body)}
+}
+
+// next decodes the next element of the array into v
+// It returns io.EOF once the closing bracket has been read
+func (d *arrayDecoder) next(v interface{}) error {
+
+	if d.done {
+		return io.EOF
+	}
+	if !d.opened {
+		if err := expectDelim(d.decoder, '['); err != nil {
+			return err
+		}
+		d.opened = true
+	}
+
+	if d.decoder.More() {
+		return d.decoder.Decode(v)
+	}
+
+	if err := expectDelim(d.decoder, ']'); err != nil {
+		return err
+	}
+	d.done = true
+	return io.EOF
+}
+
+// Close releases the underlying response
+func (d *arrayDecoder) Close() error {
+	return d.body.Close()
+}
+
+// expectDelim reads the next token and checks it is the given delimiter
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v in response but found %v", delim, token)
+	}
+	return nil
+}
+
+// BikePointIterator decodes bike points one at a time as they are read from the response
+type BikePointIterator struct {
+	*arrayDecoder
+}
+
+// Next returns the next bike point, or io.EOF once every bike point has been read
+func (it *BikePointIterator) Next() (*BikePoint, error) {
+	bikePoint := BikePoint{}
+	if err := it.next(&bikePoint); err != nil {
+		return nil, err
+	}
+	return &bikePoint, nil
+}
+
+// IterateBikePoints opens every BikePoint without buffering the response
+// The iterator must be closed once finished with
+// It queries the endpoint /BikePoint
+func (c *TflClient) IterateBikePoints() (*BikePointIterator, error) {
+
+	pathParams := []string{bikePointPath}
+	url := c.buildURL(pathParams)
+
+	body, err := c.getStream(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BikePointIterator{newArrayDecoder(body)}, nil
+}
+
+// PlaceIterator decodes places one at a time as they are read from the response
+type PlaceIterator struct {
+	*arrayDecoder
+}
+
+// Next returns the next place, or io.EOF once every place has been read
+func (it *PlaceIterator) Next() (*Place, error) {
+	place := Place{}
+	if err := it.next(&place); err != nil {
+		return nil, err
+	}
+	return &place, nil
+}
+
+// IteratePlacesByType opens every place of the given types without buffering the response
+// The iterator must be closed once finished with
+// It queries the endpoint /Place/Type/{types}
+func (c *TflClient) IteratePlacesByType(types []string, activeOnly bool) (*PlaceIterator, error) {
+
+	pathParams := []string{placePath, typePath, strings.Join(types, ",")}
+	queryParams := &map[string]string{}
+	if activeOnly {
+		(*queryParams)["activeOnly"] = "true"
+	}
+	url := c.buildURLWithQueryParams(pathParams, queryParams)
+
+	body, err := c.getStream(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlaceIterator{newArrayDecoder(body)}, nil
+}
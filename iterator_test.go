@@ -0,0 +1,135 @@
+package tfl
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_IterateBikePoints(t *testing.T) {
+
+	expected := []BikePoint{}
+	json.Unmarshal(getTestDataFileContents("bike_points.json"), &expected)
+
+	it, err := client.IterateBikePoints()
+	assert.NoError(t, err)
+	defer it.Close()
+
+	got := []BikePoint{}
+	for {
+		bikePoint, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		got = append(got, *bikePoint)
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestTflClient_IteratePlacesByType(t *testing.T) {
+
+	expected, err := client.GetPlacesByType([]string{"CarPark", "ChargeConnector"}, true)
+	assert.NoError(t, err)
+
+	it, err := client.IteratePlacesByType([]string{"CarPark", "ChargeConnector"}, true)
+	assert.NoError(t, err)
+	defer it.Close()
+
+	got := []Place{}
+	for {
+		place, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		got = append(got, *place)
+	}
+	assert.Equal(t, *expected, got)
+}
+
+func TestTflClient_maxResponseSize(t *testing.T) {
+
+	body := `{"commonName": "River Street , Clerkenwell"}`
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer stub.Close()
+
+	tests := []struct {
+		name    string
+		size    int64
+		wantErr error
+	}{
+		{name: "Should read a response of exactly the maximum size", size: int64(len(body))},
+		{name: "Should fail a response over the maximum size", size: int64(len(body)) - 1, wantErr: ErrResponseTooLarge},
+		{name: "Should read any response when the limit is removed", size: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubClient, _ := New(WithBaseURL(stub.URL), WithMaxResponseSize(tt.size))
+			got, err := stubClient.GetBikePointForID("BikePoints_1")
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "River Street , Clerkenwell", got.CommonName)
+		})
+	}
+
+	_, err := New(WithMaxResponseSize(-1))
+	assert.EqualError(t, err, "maximum response size must not be negative")
+}
+
+func TestTflClient_maxResponseSize_stream(t *testing.T) {
+
+	body := getTestDataFileContents("bike_points.json")
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer stub.Close()
+
+	// The limit bounds buffered decodes, a streamed response is never held in memory whole
+	stubClient, _ := New(WithBaseURL(stub.URL), WithMaxResponseSize(int64(len(body))/2))
+	it, err := stubClient.IterateBikePoints()
+	assert.NoError(t, err)
+	defer it.Close()
+
+	count := 0
+	for {
+		_, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			break
+		}
+		count++
+	}
+	expected := []BikePoint{}
+	json.Unmarshal(body, &expected)
+	assert.Equal(t, len(expected), count)
+
+	_, err = stubClient.GetBikePoints()
+	assert.Equal(t, ErrResponseTooLarge, err)
+}
+
+func Test_limitedBody(t *testing.T) {
+
+	body := newLimitedBody(ioutil.NopCloser(strings.NewReader("0123456789")), 4)
+	read, err := ioutil.ReadAll(body)
+	assert.Equal(t, ErrResponseTooLarge, err)
+	assert.Equal(t, "0123", string(read))
+
+	body = newLimitedBody(ioutil.NopCloser(strings.NewReader("0123")), 4)
+	read, err = ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123", string(read))
+}
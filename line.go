@@ -0,0 +1,130 @@
+package tfl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LineCoordinates decodes each of the LineStrings into its ordered coordinates
+func (r RouteSequence) LineCoordinates() ([][]Coordinate, error) {
+	lines := [][]Coordinate{}
+	for _, lineString := range r.LineStrings {
+		coordinates, err := parseLonLatPairs([]byte(lineString))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, coordinates)
+	}
+	return lines, nil
+}
+
+// GetLineRouteSequence retrieves the ordered stops and branches of a line in a direction
+// Direction must be one of inbound, outbound or all
+// It queries the endpoint /Line/{id}/Route/Sequence/{direction}
+func (c *TflClient) GetLineRouteSequence(lineID, direction string) (*RouteSequence, error) {
+
+	switch direction {
+	case "inbound", "outbound", "all":
+	default:
+		return nil, fmt.Errorf("invalid direction %q, must be one of inbound, outbound or all", direction)
+	}
+
+	pathParams := []string{linePath, lineID, routePath, sequencePath, direction}
+	url := c.buildURL(pathParams)
+
+	resp := RouteSequence{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetStopsBetween retrieves the ordered stops from one station to another on the same line, inclusive of both
+// Stations may be given by their naptan, station or hub ID
+// It queries the endpoint /Line/{id}/Route/Sequence/all
+func (c *TflClient) GetStopsBetween(lineID, fromID, toID string) (*[]StopPointAPIResponse, error) {
+
+	sequence, err := c.GetLineRouteSequence(lineID, "all")
+	if err != nil {
+		return nil, err
+	}
+
+	stops, ok := sequence.StopsBetween(fromID, toID)
+	if !ok {
+		return nil, fmt.Errorf("%s and %s are not on the same route of line %s", fromID, toID, lineID)
+	}
+
+	return &stops, nil
+}
+
+// StopsBetween returns the ordered stops from one station to another, inclusive of both
+// The shortest of the ordered routes serving both stations in that order is used
+func (r RouteSequence) StopsBetween(fromID, toID string) ([]StopPointAPIResponse, bool) {
+
+	stopsByID := r.stopsByID()
+
+	matches := func(naptanID, id string) bool {
+		if strings.EqualFold(naptanID, id) {
+			return true
+		}
+		stop, ok := stopsByID[naptanID]
+		return ok && (strings.EqualFold(stop.StationID, id) || strings.EqualFold(stop.TopMostParentID, id))
+	}
+
+	var shortest []string
+	for _, route := range r.OrderedLineRoutes {
+		from, to := -1, -1
+		for i, naptanID := range route.NaptanIDs {
+			if from == -1 && matches(naptanID, fromID) {
+				from = i
+			}
+			if from != -1 && matches(naptanID, toID) {
+				to = i
+				break
+			}
+		}
+		if from == -1 || to == -1 {
+			continue
+		}
+		if shortest == nil || to-from+1 < len(shortest) {
+			shortest = route.NaptanIDs[from : to+1]
+		}
+	}
+	if shortest == nil {
+		return nil, false
+	}
+
+	stops := []StopPointAPIResponse{}
+	for _, naptanID := range shortest {
+		stops = append(stops, stopPointFromMatchedStop(naptanID, stopsByID[naptanID]))
+	}
+	return stops, true
+}
+
+func stopPointFromMatchedStop(naptanID string, stop EntityMatchedStop) StopPointAPIResponse {
+	return StopPointAPIResponse{
+		NaptanID:      naptanID,
+		ID:            naptanID,
+		Modes:         stop.Modes,
+		IcsCode:       stop.IcsCode,
+		StationNaptan: stop.StationID,
+		CommonName:    stop.Name,
+		Lat:           stop.Lat,
+		Lon:           stop.Lon,
+	}
+}
+
+// stopsByID returns every stop of the sequence keyed by its ID
+func (r RouteSequence) stopsByID() map[string]EntityMatchedStop {
+	stops := map[string]EntityMatchedStop{}
+	for _, stop := range r.Stations {
+		stops[stop.ID] = stop
+	}
+	for _, stopPointSequence := range r.StopPointSequences {
+		for _, stop := range stopPointSequence.StopPoint {
+			stops[stop.ID] = stop
+		}
+	}
+	return stops
+}
@@ -0,0 +1,100 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetLineRouteSequence(t *testing.T) {
+
+	expected := RouteSequence{}
+	json.Unmarshal(getTestDataFileContents("line_route_sequence.json"), &expected)
+
+	tests := []struct {
+		name      string
+		direction string
+		want      *RouteSequence
+		wantErr   string
+	}{
+		{
+			name:      "Should retrieve route sequence for a line",
+			direction: "all",
+			want:      &expected,
+		},
+		{
+			name:      "Should reject an invalid direction",
+			direction: "sideways",
+			wantErr:   `invalid direction "sideways", must be one of inbound, outbound or all`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetLineRouteSequence("victoria", tt.direction)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRouteSequence_LineCoordinates(t *testing.T) {
+
+	sequence := RouteSequence{}
+	json.Unmarshal(getTestDataFileContents("line_route_sequence.json"), &sequence)
+
+	got, err := sequence.LineCoordinates()
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Len(t, got[0], 7)
+	assert.Equal(t, Coordinate{Lat: 51.462618, Lon: -0.114888}, got[0][0])
+}
+
+func TestTflClient_GetStopsBetween(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "Should retrieve stops between two stations",
+			from: "940GZZLUVXL",
+			to:   "940GZZLUGPK",
+			want: []string{"Vauxhall Underground Station", "Pimlico Underground Station", "Victoria Underground Station", "Green Park Underground Station"},
+		},
+		{
+			name: "Should retrieve stops in the opposite direction by hub ID",
+			from: "HUBVIC",
+			to:   "940GZZLUVXL",
+			want: []string{"Victoria Underground Station", "Pimlico Underground Station", "Vauxhall Underground Station"},
+		},
+		{
+			name:    "Should handle stations not on the line",
+			from:    "940GZZLUVXL",
+			to:      "940GZZLUKSX",
+			wantErr: "940GZZLUVXL and 940GZZLUKSX are not on the same route of line victoria",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetStopsBetween("victoria", tt.from, tt.to)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			names := []string{}
+			for _, stop := range *got {
+				names = append(names, stop.CommonName)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
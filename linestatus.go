@@ -0,0 +1,268 @@
+package tfl
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxBackoffIntervals is how many intervals a LineStatusWatcher backs off for at most by default
+const defaultMaxBackoffIntervals = 16
+
+// LineStatusChange is a change in the severities of a line's statuses
+// Before and After are the line's current status, which may be the same when a less severe status changed
+type LineStatusChange struct {
+	LineID   string
+	LineName string
+	Before   LineStatus
+	After    LineStatus
+}
+
+// LineStatusHandler is called with each change a LineStatusWatcher sees
+type LineStatusHandler func(LineStatusChange)
+
+// LineStatusWatcher polls the status of lines and calls its handlers when any of a line's severities change
+// Failed polls back off exponentially from Interval up to MaxBackoff,
+// which is defaultMaxBackoffIntervals times Interval if it is less than Interval
+type LineStatusWatcher struct {
+	Interval   time.Duration
+	MaxBackoff time.Duration
+
+	client        *TflClient
+	lineIDs       []string
+	mu            sync.Mutex
+	handlers      []LineStatusHandler
+	errorHandlers []func(error)
+	last          map[string]LineStatus
+	severities    map[string]string
+}
+
+// statusSeverityOrder lists TfL's status severity codes from most to least severe
+// The codes themselves are not in order of severity, Special Service is 0 and Not Running 16
+var statusSeverityOrder = []int{
+	1,  // Closed
+	20, // Service Closed
+	2,  // Suspended
+	16, // Not Running
+	4,  // Planned Closure
+	3,  // Part Suspended
+	5,  // Part Closure
+	11, // Part Closed
+	6,  // Severe Delays
+	7,  // Reduced Service
+	8,  // Bus Service
+	15, // Diverted
+	9,  // Minor Delays
+	12, // Exit Only
+	13, // No Step Free Access
+	14, // Change of frequency
+	17, // Issues Reported
+	0,  // Special Service
+	19, // Information
+	18, // No Issues
+	10, // Good Service
+}
+
+// severityRank returns how severe a status severity code is, lower is more severe
+// Codes TfL has not documented rank just above Good Service
+func severityRank(severity int) int {
+	for rank, code := range statusSeverityOrder {
+		if code == severity {
+			return rank
+		}
+	}
+	return len(statusSeverityOrder) - 1
+}
+
+// CurrentStatus returns the status of the line that applies now, which is the most severe
+func (l Line) CurrentStatus() (LineStatus, bool) {
+	var current LineStatus
+	found := false
+	for _, status := range l.LineStatuses {
+		if !found || severityRank(status.StatusSeverity) < severityRank(current.StatusSeverity) {
+			current = status
+			found = true
+		}
+	}
+	return current, found
+}
+
+// severities returns the line's status severity codes in order, to tell when any of them change
+func (l Line) severities() string {
+	codes := []string{}
+	for _, status := range l.LineStatuses {
+		codes = append(codes, strconv.Itoa(status.StatusSeverity))
+	}
+	sort.Strings(codes)
+	return strings.Join(codes, ",")
+}
+
+// GetLineStatus retrieves the status of lines
+// It queries the endpoint /Line/{ids}/Status
+func (c *TflClient) GetLineStatus(lineIDs []string) (*[]Line, error) {
+	return c.getLineStatus(context.Background(), lineIDs)
+}
+
+func (c *TflClient) getLineStatus(ctx context.Context, lineIDs []string) (*[]Line, error) {
+
+	pathParams := []string{linePath, strings.Join(lineIDs, ","), statusPath}
+	url := c.buildURL(pathParams)
+
+	resp := []Line{}
+	if err := c.getJSONContext(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetLineStatusByMode retrieves the status of every line of the given modes
+// It queries the endpoint /Line/Mode/{modes}/Status
+func (c *TflClient) GetLineStatusByMode(modes []string) (*[]Line, error) {
+
+	pathParams := []string{linePath, modePath, strings.Join(modes, ","), statusPath}
+	url := c.buildURL(pathParams)
+
+	resp := []Line{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// NewLineStatusWatcher returns a LineStatusWatcher for the lines which polls on the interval when run
+func (c *TflClient) NewLineStatusWatcher(lineIDs []string, interval time.Duration) *LineStatusWatcher {
+	return &LineStatusWatcher{
+		Interval:   interval,
+		MaxBackoff: interval * defaultMaxBackoffIntervals,
+		client:     c,
+		lineIDs:    lineIDs,
+		last:       map[string]LineStatus{},
+		severities: map[string]string{},
+	}
+}
+
+// OnChange registers a handler to be called when any of a line's status severities change
+func (w *LineStatusWatcher) OnChange(handler LineStatusHandler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, handler)
+}
+
+// OnError registers a handler to be called when polling the API fails
+func (w *LineStatusWatcher) OnError(handler func(error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.errorHandlers = append(w.errorHandlers, handler)
+}
+
+// LastStatus returns the last known status of a line
+func (w *LineStatusWatcher) LastStatus(lineID string) (LineStatus, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	status, ok := w.last[lineID]
+	return status, ok
+}
+
+// Check polls the status of the lines once and calls the handlers for every line whose severities have changed
+// The first status seen for a line is recorded without calling the handlers
+func (w *LineStatusWatcher) Check() error {
+	return w.CheckContext(context.Background())
+}
+
+// CheckContext is Check with a context that cancels the request
+func (w *LineStatusWatcher) CheckContext(ctx context.Context) error {
+
+	lines, err := w.client.getLineStatus(ctx, w.lineIDs)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	changes := []LineStatusChange{}
+	for _, line := range *lines {
+		status, ok := line.CurrentStatus()
+		if !ok {
+			continue
+		}
+		severities := line.severities()
+		if before, seen := w.severities[line.ID]; seen && before != severities {
+			changes = append(changes, LineStatusChange{
+				LineID:   line.ID,
+				LineName: line.Name,
+				Before:   w.last[line.ID],
+				After:    status,
+			})
+		}
+		w.last[line.ID] = status
+		w.severities[line.ID] = severities
+	}
+	handlers := append([]LineStatusHandler{}, w.handlers...)
+	w.mu.Unlock()
+
+	for _, change := range changes {
+		for _, handler := range handlers {
+			handler(change)
+		}
+	}
+	return nil
+}
+
+// Run checks the lines immediately and then on the watcher's interval until the context is cancelled
+// It returns the context's error once it stops, or an error straight away if the interval is not positive
+func (w *LineStatusWatcher) Run(ctx context.Context) error {
+
+	if w.Interval <= 0 {
+		return errors.New("line status interval must be positive")
+	}
+
+	failures := 0
+	for {
+		wait := w.Interval
+		err := w.CheckContext(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			failures++
+			wait = w.backoff(failures)
+			w.mu.Lock()
+			errorHandlers := append([]func(error){}, w.errorHandlers...)
+			w.mu.Unlock()
+			for _, handler := range errorHandlers {
+				handler(err)
+			}
+		} else {
+			failures = 0
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// backoff returns the wait after the given number of consecutive failures
+func (w *LineStatusWatcher) backoff(failures int) time.Duration {
+	maxBackoff := w.MaxBackoff
+	if maxBackoff < w.Interval {
+		maxBackoff = w.Interval * defaultMaxBackoffIntervals
+	}
+	wait := w.Interval
+	for i := 0; i < failures && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,244 @@
+package tfl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetLineStatus(t *testing.T) {
+
+	expected := []Line{}
+	json.Unmarshal(getTestDataFileContents("line_status.json"), &expected)
+
+	got, err := client.GetLineStatus([]string{"northern", "victoria"})
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+
+	status, ok := (*got)[0].CurrentStatus()
+	assert.True(t, ok)
+	assert.Equal(t, "Minor Delays", status.StatusSeverityDescription)
+
+	got, err = client.GetLineStatusByMode([]string{"tube"})
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+// lineStatusStub serves the given responses in turn, repeating the last one
+// A nil response is served as an API error
+type lineStatusStub struct {
+	mu        sync.Mutex
+	responses [][]Line
+	calls     int
+}
+
+func (s *lineStatusStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.calls
+	if i >= len(s.responses) {
+		i = len(s.responses) - 1
+	}
+	s.calls++
+	if s.responses[i] == nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"Internal Server Error"}`))
+		return
+	}
+	body, _ := json.Marshal(s.responses[i])
+	w.Write(body)
+}
+
+func northernLine(severity int, description string) []Line {
+	return []Line{{
+		ID:           "northern",
+		Name:         "Northern",
+		LineStatuses: []LineStatus{{StatusSeverity: severity, StatusSeverityDescription: description}},
+	}}
+}
+
+func TestLineStatusWatcher_Check(t *testing.T) {
+
+	stub := &lineStatusStub{responses: [][]Line{
+		northernLine(10, "Good Service"),
+		northernLine(10, "Good Service"),
+		northernLine(9, "Minor Delays"),
+	}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	stubClient, _ := New(WithBaseURL(server.URL))
+
+	watcher := stubClient.NewLineStatusWatcher([]string{"northern"}, time.Minute)
+	changes := []LineStatusChange{}
+	watcher.OnChange(func(change LineStatusChange) {
+		changes = append(changes, change)
+	})
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, watcher.Check())
+	}
+
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "Good Service", changes[0].Before.StatusSeverityDescription)
+	assert.Equal(t, "Minor Delays", changes[0].After.StatusSeverityDescription)
+
+	last, ok := watcher.LastStatus("northern")
+	assert.True(t, ok)
+	assert.Equal(t, 9, last.StatusSeverity)
+}
+
+func TestLine_CurrentStatus(t *testing.T) {
+
+	line := Line{LineStatuses: []LineStatus{
+		{StatusSeverity: 0, StatusSeverityDescription: "Special Service"},
+		{StatusSeverity: 6, StatusSeverityDescription: "Severe Delays"},
+	}}
+	status, ok := line.CurrentStatus()
+	assert.True(t, ok)
+	assert.Equal(t, "Severe Delays", status.StatusSeverityDescription)
+
+	line.LineStatuses = append(line.LineStatuses, LineStatus{StatusSeverity: 16, StatusSeverityDescription: "Not Running"})
+	status, _ = line.CurrentStatus()
+	assert.Equal(t, "Not Running", status.StatusSeverityDescription)
+
+	_, ok = Line{}.CurrentStatus()
+	assert.False(t, ok)
+}
+
+func TestLineStatusWatcher_Check_secondStatus(t *testing.T) {
+
+	withStatuses := func(statuses ...LineStatus) []Line {
+		return []Line{{ID: "northern", Name: "Northern", LineStatuses: statuses}}
+	}
+	severe := LineStatus{StatusSeverity: 6, StatusSeverityDescription: "Severe Delays"}
+	stub := &lineStatusStub{responses: [][]Line{
+		withStatuses(severe, LineStatus{StatusSeverity: 9, StatusSeverityDescription: "Minor Delays"}),
+		withStatuses(severe, LineStatus{StatusSeverity: 5, StatusSeverityDescription: "Part Closure"}),
+	}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	stubClient, _ := New(WithBaseURL(server.URL))
+
+	watcher := stubClient.NewLineStatusWatcher([]string{"northern"}, time.Minute)
+	changes := []LineStatusChange{}
+	watcher.OnChange(func(change LineStatusChange) {
+		changes = append(changes, change)
+	})
+	assert.NoError(t, watcher.Check())
+	assert.NoError(t, watcher.Check())
+
+	assert.Len(t, changes, 1, "a change to the second status should be seen")
+	assert.Equal(t, "Severe Delays", changes[0].Before.StatusSeverityDescription)
+	assert.Equal(t, "Part Closure", changes[0].After.StatusSeverityDescription)
+}
+
+func TestLineStatusWatcher_Run(t *testing.T) {
+
+	stub := &lineStatusStub{responses: [][]Line{
+		northernLine(10, "Good Service"),
+		nil,
+		nil,
+		northernLine(6, "Severe Delays"),
+	}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	stubClient, _ := New(WithBaseURL(server.URL))
+
+	watcher := stubClient.NewLineStatusWatcher([]string{"northern"}, time.Millisecond)
+	changed := make(chan LineStatusChange, 1)
+	watcher.OnChange(func(change LineStatusChange) {
+		changed <- change
+	})
+	errs := make(chan error, 2)
+	watcher.OnError(func(err error) {
+		errs <- err
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	change := <-changed
+	assert.Equal(t, 6, change.After.StatusSeverity)
+	assert.EqualError(t, <-errs, "Internal Server Error")
+	assert.EqualError(t, <-errs, "Internal Server Error")
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
+
+func TestLineStatusWatcher_Run_cancelDuringCheck(t *testing.T) {
+
+	requested := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	stubClient, _ := New(WithBaseURL(server.URL))
+
+	watcher := stubClient.NewLineStatusWatcher([]string{"northern"}, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+	<-requested
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop while a check was in flight")
+	}
+}
+
+func TestLineStatusWatcher_Run_invalidInterval(t *testing.T) {
+	watcher := client.NewLineStatusWatcher([]string{"northern"}, 0)
+	assert.EqualError(t, watcher.Run(context.Background()), "line status interval must be positive")
+}
+
+func TestLineStatusWatcher_backoff(t *testing.T) {
+
+	watcher := client.NewLineStatusWatcher([]string{"northern"}, time.Second)
+	watcher.MaxBackoff = 5 * time.Second
+
+	assert.Equal(t, 2*time.Second, watcher.backoff(1))
+	assert.Equal(t, 4*time.Second, watcher.backoff(2))
+	assert.Equal(t, 5*time.Second, watcher.backoff(3))
+	assert.Equal(t, 5*time.Second, watcher.backoff(10))
+
+	watcher = client.NewLineStatusWatcher([]string{"northern"}, 0)
+	watcher.Interval = time.Second
+	assert.Equal(t, 2*time.Second, watcher.backoff(1), "max backoff below the interval should fall back to the default")
+	assert.Equal(t, 16*time.Second, watcher.backoff(10))
+}
+
+func TestLineStatusWatcher_Run_everyPollFails(t *testing.T) {
+
+	stub := &lineStatusStub{responses: [][]Line{nil}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	stubClient, _ := New(WithBaseURL(server.URL))
+
+	watcher := stubClient.NewLineStatusWatcher([]string{"northern"}, 0)
+	watcher.Interval = 20 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, watcher.Run(ctx))
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+	// Backing off from 20ms polls at 0, 40ms and 120ms
+	assert.LessOrEqual(t, stub.calls, 4, "failed polls should back off")
+}
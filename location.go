@@ -0,0 +1,69 @@
+package tfl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Location is an origin or destination of the journey planner as it appears in the request path
+// Build one with a constructor to have it validated, string literals such as ICS codes are passed through as is
+type Location string
+
+var (
+	icsCodePattern  = regexp.MustCompile(`^[0-9]{7}$`)
+	postcodePattern = regexp.MustCompile(`^([A-Z]{1,2}[0-9][A-Z0-9]?) ?([0-9][A-Z]{2})$`)
+	naptanPattern   = regexp.MustCompile(`^[0-9]{3}[0-9A-Z]{1,9}$`)
+)
+
+// NewICSCodeLocation returns the location of a stop by its seven digit ICS code, e.g. 1001089
+func NewICSCodeLocation(code string) (Location, error) {
+	code = strings.TrimSpace(code)
+	if !icsCodePattern.MatchString(code) {
+		return "", fmt.Errorf("invalid ICS code %q, must be seven digits", code)
+	}
+	return Location(code), nil
+}
+
+// NewPostcodeLocation returns the location of a UK postcode, normalised to upper case with a single space, e.g. SW1A 2AA
+func NewPostcodeLocation(postcode string) (Location, error) {
+	normalised := strings.ToUpper(strings.Join(strings.Fields(postcode), ""))
+	parts := postcodePattern.FindStringSubmatch(normalised)
+	if parts == nil {
+		return "", fmt.Errorf("invalid postcode %q", postcode)
+	}
+	return Location(parts[1] + " " + parts[2]), nil
+}
+
+// NewCoordinateLocation returns the location of a coordinate as the lat,lon pair the journey planner accepts
+func NewCoordinateLocation(coordinate Coordinate) (Location, error) {
+	if math.IsNaN(coordinate.Lat) || math.IsNaN(coordinate.Lon) ||
+		coordinate.Lat < -90 || coordinate.Lat > 90 || coordinate.Lon < -180 || coordinate.Lon > 180 {
+		return "", fmt.Errorf("invalid coordinate %v,%v, latitude must be within ±90 and longitude within ±180", coordinate.Lat, coordinate.Lon)
+	}
+	return Location(formatFloat(coordinate.Lat) + "," + formatFloat(coordinate.Lon)), nil
+}
+
+// NewNaptanLocation returns the location of a stop by its NaPTAN ID, normalised to upper case, e.g. 940GZZLUKSX
+func NewNaptanLocation(naptanID string) (Location, error) {
+	normalised := strings.ToUpper(strings.TrimSpace(naptanID))
+	if !naptanPattern.MatchString(normalised) {
+		return "", fmt.Errorf("invalid NaPTAN ID %q, must be a three digit area code followed by letters and digits", naptanID)
+	}
+	return Location(normalised), nil
+}
+
+// NewFreeTextLocation returns a place name for the journey planner to resolve, e.g. Canary Wharf
+// The text is a single path segment so must not contain a slash
+func NewFreeTextLocation(text string) (Location, error) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return "", errors.New("free text location must not be empty")
+	}
+	if strings.Contains(trimmed, "/") {
+		return "", fmt.Errorf("invalid free text location %q, must not contain a slash", text)
+	}
+	return Location(trimmed), nil
+}
@@ -0,0 +1,119 @@
+package tfl
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func() (Location, error)
+		want    Location
+		wantErr string
+	}{
+		{
+			name:  "Should accept an ICS code",
+			build: func() (Location, error) { return NewICSCodeLocation(" 1001089 ") },
+			want:  "1001089",
+		},
+		{
+			name:    "Should reject a short ICS code",
+			build:   func() (Location, error) { return NewICSCodeLocation("100108") },
+			wantErr: `invalid ICS code "100108", must be seven digits`,
+		},
+		{
+			name:  "Should normalise a postcode",
+			build: func() (Location, error) { return NewPostcodeLocation("sw1a2aa") },
+			want:  "SW1A 2AA",
+		},
+		{
+			name:  "Should collapse the spaces of a postcode",
+			build: func() (Location, error) { return NewPostcodeLocation(" E14  5AB ") },
+			want:  "E14 5AB",
+		},
+		{
+			name:    "Should reject an invalid postcode",
+			build:   func() (Location, error) { return NewPostcodeLocation("LONDON") },
+			wantErr: `invalid postcode "LONDON"`,
+		},
+		{
+			name:  "Should format a coordinate as lat,lon",
+			build: func() (Location, error) { return NewCoordinateLocation(Coordinate{Lat: 51.5054, Lon: -0.0235}) },
+			want:  "51.5054,-0.0235",
+		},
+		{
+			name:    "Should reject a coordinate out of range",
+			build:   func() (Location, error) { return NewCoordinateLocation(Coordinate{Lat: -0.0235, Lon: 251.5054}) },
+			wantErr: "invalid coordinate -0.0235,251.5054, latitude must be within ±90 and longitude within ±180",
+		},
+		{
+			name:    "Should reject a coordinate that is not a number",
+			build:   func() (Location, error) { return NewCoordinateLocation(Coordinate{Lat: math.NaN()}) },
+			wantErr: "invalid coordinate NaN,0, latitude must be within ±90 and longitude within ±180",
+		},
+		{
+			name:  "Should normalise a NaPTAN ID",
+			build: func() (Location, error) { return NewNaptanLocation("940gzzlucyf") },
+			want:  "940GZZLUCYF",
+		},
+		{
+			name:    "Should reject a NaPTAN ID without an area code",
+			build:   func() (Location, error) { return NewNaptanLocation("HUBCYF") },
+			wantErr: `invalid NaPTAN ID "HUBCYF", must be a three digit area code followed by letters and digits`,
+		},
+		{
+			name:  "Should trim free text",
+			build: func() (Location, error) { return NewFreeTextLocation(" Canary Wharf ") },
+			want:  "Canary Wharf",
+		},
+		{
+			name:    "Should reject empty free text",
+			build:   func() (Location, error) { return NewFreeTextLocation("  ") },
+			wantErr: "free text location must not be empty",
+		},
+		{
+			name:    "Should reject free text with a slash",
+			build:   func() (Location, error) { return NewFreeTextLocation("Bank/Monument") },
+			wantErr: `invalid free text location "Bank/Monument", must not contain a slash`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.build()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTflClient_GetJourneyPlannerItinerary_locations(t *testing.T) {
+
+	requested := ""
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.EscapedPath()
+		w.Write([]byte(`{"journeys": []}`))
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	from, _ := NewPostcodeLocation("SW1A 2AA")
+	to, _ := NewCoordinateLocation(Coordinate{Lat: 51.5054, Lon: -0.0235})
+	_, err := stubClient.GetJourneyPlannerItinerary(JourneyPlannerQuery{From: from, To: to})
+	assert.NoError(t, err)
+	assert.Equal(t, "/Journey/JourneyResults/SW1A%202AA/to/51.5054,-0.0235", requested)
+
+	from, _ = NewFreeTextLocation("Canary Wharf")
+	to, _ = NewNaptanLocation("940GZZLUKSX")
+	_, err = stubClient.GetJourneyPlannerItinerary(JourneyPlannerQuery{From: from, To: to})
+	assert.NoError(t, err)
+	assert.Equal(t, "/Journey/JourneyResults/Canary%20Wharf/to/940GZZLUKSX", requested)
+}
@@ -0,0 +1,108 @@
+package tfl
+
+import "strings"
+
+// CarParkAvailability joins a car park's Place details with its occupancy
+type CarParkAvailability struct {
+	Place     Place
+	Details   CarParkDetails
+	Occupancy CarParkOccupancy
+	Free      int
+	Occupied  int
+	BayCount  int
+}
+
+// Totals returns the free, occupied and total bays across every bay type of the car park
+func (o CarParkOccupancy) Totals() (free, occupied, bayCount int) {
+	for _, bay := range o.Bays {
+		free += bay.Free
+		occupied += bay.Occupied
+		bayCount += bay.BayCount
+	}
+	return free, occupied, bayCount
+}
+
+// GetCarParkOccupancies retrieves the occupancy of every car park
+// It queries the endpoint /Occupancy/CarPark
+func (c *TflClient) GetCarParkOccupancies() (*[]CarParkOccupancy, error) {
+
+	pathParams := []string{occupancyPath, carParkPath}
+	url := c.buildURL(pathParams)
+
+	resp := []CarParkOccupancy{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCarParkOccupancy retrieves the occupancy of a car park
+// It queries the endpoint /Occupancy/CarPark/{id}
+func (c *TflClient) GetCarParkOccupancy(id string) (*CarParkOccupancy, error) {
+
+	pathParams := []string{occupancyPath, carParkPath, id}
+	url := c.buildURL(pathParams)
+
+	resp := CarParkOccupancy{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetChargeConnectorOccupancies retrieves the status of every charge connector
+// It queries the endpoint /Occupancy/ChargeConnector
+func (c *TflClient) GetChargeConnectorOccupancies() (*[]ChargeConnectorOccupancy, error) {
+
+	pathParams := []string{occupancyPath, chargeConnectorPath}
+	url := c.buildURL(pathParams)
+
+	resp := []ChargeConnectorOccupancy{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetBikePointOccupancies retrieves the occupancy of BikePoints
+// It queries the endpoint /Occupancy/BikePoints/{ids}
+func (c *TflClient) GetBikePointOccupancies(ids []string) (*[]BikePointOccupancy, error) {
+
+	pathParams := []string{occupancyPath, bikePointsPath, strings.Join(ids, ",")}
+	url := c.buildURL(pathParams)
+
+	resp := []BikePointOccupancy{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCarParkAvailability retrieves a car park's details and occupancy together
+// It queries the endpoints /Place/{id} and /Occupancy/CarPark/{id}
+func (c *TflClient) GetCarParkAvailability(id string) (*CarParkAvailability, error) {
+
+	place, err := c.GetPlaceForID(id)
+	if err != nil {
+		return nil, err
+	}
+	occupancy, err := c.GetCarParkOccupancy(id)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &CarParkAvailability{
+		Place:     *place,
+		Occupancy: *occupancy,
+	}
+	if details, ok := place.Details().(CarParkDetails); ok {
+		availability.Details = details
+	}
+	availability.Free, availability.Occupied, availability.BayCount = occupancy.Totals()
+
+	return availability, nil
+}
@@ -0,0 +1,68 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetCarParkOccupancies(t *testing.T) {
+
+	expected := []CarParkOccupancy{}
+	json.Unmarshal(getTestDataFileContents("car_park_occupancies.json"), &expected)
+
+	got, err := client.GetCarParkOccupancies()
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestTflClient_GetCarParkOccupancy(t *testing.T) {
+
+	got, err := client.GetCarParkOccupancy("CarParks_800491")
+	assert.NoError(t, err)
+	assert.Equal(t, Bay{BayType: "Disabled", BayCount: 20, Free: 5, Occupied: 15}, got.Bays[0])
+
+	free, occupied, bayCount := got.Totals()
+	assert.Equal(t, 105, free)
+	assert.Equal(t, 345, occupied)
+	assert.Equal(t, 450, bayCount)
+}
+
+func TestTflClient_GetChargeConnectorOccupancies(t *testing.T) {
+
+	expected := []ChargeConnectorOccupancy{}
+	json.Unmarshal(getTestDataFileContents("charge_connector_occupancies.json"), &expected)
+
+	got, err := client.GetChargeConnectorOccupancies()
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestTflClient_GetBikePointOccupancies(t *testing.T) {
+
+	got, err := client.GetBikePointOccupancies([]string{"BikePoints_1"})
+	assert.NoError(t, err)
+	assert.Equal(t, &[]BikePointOccupancy{{
+		ID:                 "BikePoints_1",
+		Name:               "River Street , Clerkenwell",
+		BikesCount:         10,
+		EmptyDocks:         8,
+		TotalDocks:         19,
+		StandardBikesCount: 8,
+		EBikesCount:        2,
+	}}, got)
+}
+
+func TestTflClient_GetCarParkAvailability(t *testing.T) {
+
+	got, err := client.GetCarParkAvailability("CarParks_800491")
+	assert.NoError(t, err)
+	assert.Equal(t, "Stratford (Westfield) Car Park", got.Place.CommonName)
+	assert.Equal(t, 450, got.Details.NumberOfSpaces)
+	assert.Equal(t, "Westfield", got.Details.Operator)
+	assert.Len(t, got.Occupancy.Bays, 2)
+	assert.Equal(t, 105, got.Free)
+	assert.Equal(t, 345, got.Occupied)
+	assert.Equal(t, 450, got.BayCount)
+}
@@ -0,0 +1,118 @@
+package tfl
+
+import "time"
+
+// peakWindow is a period of a weekday in which peak fares are charged
+type peakWindow struct {
+	start, end time.Duration
+}
+
+// peakWindows are the weekday periods in which TfL charges peak fares
+var peakWindows = []peakWindow{
+	{start: 6*time.Hour + 30*time.Minute, end: 9*time.Hour + 30*time.Minute},
+	{start: 16 * time.Hour, end: 19 * time.Hour},
+}
+
+// PeakFareComparison compares the fare charged for a journey against leaving at the earliest off peak time
+type PeakFareComparison struct {
+	Journey         JourneyPlannerJourney
+	Departure       time.Time
+	IsPeak          bool
+	Charged         uint16
+	PeakCost        uint16
+	OffPeakCost     uint16
+	EarliestOffPeak time.Time
+	Saving          uint16
+}
+
+// IsPeakTime reports whether the time falls in a weekday peak window
+// Weekends and the given public holidays are always off peak
+func IsPeakTime(t time.Time, holidays []time.Time) bool {
+	_, ok := currentPeakWindow(t, holidays)
+	return ok
+}
+
+// NextOffPeakTime returns the earliest time at or after t at which off peak fares are charged
+func NextOffPeakTime(t time.Time, holidays []time.Time) time.Time {
+	window, ok := currentPeakWindow(t, holidays)
+	if !ok {
+		return t
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return midnight.Add(window.end)
+}
+
+func currentPeakWindow(t time.Time, holidays []time.Time) (peakWindow, bool) {
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return peakWindow{}, false
+	}
+	for _, holiday := range holidays {
+		if holiday.Year() == t.Year() && holiday.YearDay() == t.YearDay() {
+			return peakWindow{}, false
+		}
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	sinceMidnight := t.Sub(midnight)
+	for _, window := range peakWindows {
+		if sinceMidnight >= window.start && sinceMidnight < window.end {
+			return window, true
+		}
+	}
+	return peakWindow{}, false
+}
+
+// ComparePeakFares retrieves the journeys for a query and works out the fare charged for each
+// along with the saving made by leaving at the earliest off peak time instead
+// It queries the endpoint /Journey/JourneyResult/{from}/to/{to}
+func (c *TflClient) ComparePeakFares(query JourneyPlannerQuery, holidays []time.Time) (*[]PeakFareComparison, error) {
+
+	itinerary, err := c.GetJourneyPlannerItinerary(query)
+	if err != nil {
+		return nil, err
+	}
+
+	comparisons := []PeakFareComparison{}
+	for _, journey := range itinerary.Journeys {
+		comparison, err := comparePeakFare(journey, holidays)
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, *comparison)
+	}
+
+	return &comparisons, nil
+}
+
+func comparePeakFare(journey JourneyPlannerJourney, holidays []time.Time) (*PeakFareComparison, error) {
+
+	departure, err := parseTflTime(journey.StartDateTime)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &PeakFareComparison{
+		Journey:         journey,
+		Departure:       departure,
+		IsPeak:          IsPeakTime(departure, holidays),
+		EarliestOffPeak: NextOffPeakTime(departure, holidays),
+	}
+
+	for _, fare := range journey.Fare.Fares {
+		peak, offPeak := fare.PeakCost, fare.OffPeakCost
+		if peak == 0 && offPeak == 0 {
+			peak, offPeak = fare.Cost, fare.Cost
+		}
+		comparison.PeakCost += peak
+		comparison.OffPeakCost += offPeak
+	}
+
+	comparison.Charged = comparison.OffPeakCost
+	if comparison.IsPeak {
+		comparison.Charged = comparison.PeakCost
+	}
+	if comparison.Charged > comparison.OffPeakCost {
+		comparison.Saving = comparison.Charged - comparison.OffPeakCost
+	}
+
+	return comparison, nil
+}
@@ -0,0 +1,78 @@
+package tfl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPeakTime(t *testing.T) {
+
+	holidays := []time.Time{at("2020-08-31T00:00:00")}
+
+	tests := []struct {
+		name  string
+		at    time.Time
+		want  bool
+		wantN time.Time
+	}{
+		{
+			name:  "Should be peak during the weekday morning",
+			at:    at("2020-08-17T06:30:00"),
+			want:  true,
+			wantN: at("2020-08-17T09:30:00"),
+		},
+		{
+			name:  "Should be off peak at the end of the morning peak",
+			at:    at("2020-08-17T09:30:00"),
+			want:  false,
+			wantN: at("2020-08-17T09:30:00"),
+		},
+		{
+			name:  "Should be peak during the weekday evening",
+			at:    at("2020-08-17T18:59:00"),
+			want:  true,
+			wantN: at("2020-08-17T19:00:00"),
+		},
+		{
+			name:  "Should be off peak at weekends",
+			at:    at("2020-08-22T08:00:00"),
+			want:  false,
+			wantN: at("2020-08-22T08:00:00"),
+		},
+		{
+			name:  "Should be off peak on public holidays",
+			at:    at("2020-08-31T08:00:00"),
+			want:  false,
+			wantN: at("2020-08-31T08:00:00"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPeakTime(tt.at, holidays))
+			assert.Equal(t, tt.wantN, NextOffPeakTime(tt.at, holidays))
+		})
+	}
+}
+
+func TestTflClient_ComparePeakFares(t *testing.T) {
+
+	query := JourneyPlannerQuery{
+		From:  "1001089",
+		To:    "1000173",
+		Date:  "20190401",
+		Time:  "0715",
+		Modes: []string{"national-rail", "tube"},
+	}
+
+	got, err := client.ComparePeakFares(query, nil)
+	assert.NoError(t, err)
+	assert.Len(t, *got, 3)
+
+	first := (*got)[0]
+	assert.True(t, first.IsPeak)
+	assert.Equal(t, uint16(700), first.Charged)
+	assert.Equal(t, uint16(200), first.Saving)
+	assert.Equal(t, at("2019-04-01T09:30:00"), first.EarliestOffPeak)
+}
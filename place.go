@@ -0,0 +1,241 @@
+package tfl
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// The place types with typed details
+const (
+	CarParkPlaceType     string = "CarPark"
+	ChargePointPlaceType string = "ChargeConnector"
+	TaxiRankPlaceType    string = "TaxiRank"
+	CoachBayPlaceType    string = "CoachBay"
+)
+
+// PlaceRadiusQuery is used to hold the data for querying GetPlacesWithinRadius
+// Radius is in metres, an empty Types returns places of every type
+type PlaceRadiusQuery struct {
+	Lat, Lon   float64
+	Radius     float64
+	Types      []string
+	Categories []string
+}
+
+// PlaceBoundsQuery is used to hold the data for querying GetPlacesWithinBounds
+// An empty Types returns places of every type
+type PlaceBoundsQuery struct {
+	SwLat, SwLon float64
+	NeLat, NeLon float64
+	Types        []string
+}
+
+// PlaceDetails is the typed form of a Place's additional properties, which depend on the type of place
+type PlaceDetails interface {
+	PlaceType() string
+}
+
+// CarParkDetails are the details of a car park
+type CarParkDetails struct {
+	NumberOfSpaces       int
+	NumberOfDisabledBays int
+	OpeningHours         string
+	Operator             string
+}
+
+// ChargePointDetails are the details of an electric vehicle charge connector
+type ChargePointDetails struct {
+	ConnectorType string
+	PowerKW       float64
+	Operator      string
+	Status        string
+}
+
+// TaxiRankDetails are the details of a taxi rank
+type TaxiRankDetails struct {
+	NumberOfSpaces int
+	OperationDays  string
+	OperationTimes string
+}
+
+// CoachBayDetails are the details of a coach bay
+type CoachBayDetails struct {
+	NumberOfSpaces int
+	Restrictions   string
+}
+
+// OtherPlaceDetails are the additional properties of a place without typed details
+type OtherPlaceDetails struct {
+	Type       string
+	Properties map[string]string
+}
+
+// PlaceType returns CarParkPlaceType
+func (CarParkDetails) PlaceType() string { return CarParkPlaceType }
+
+// PlaceType returns ChargePointPlaceType
+func (ChargePointDetails) PlaceType() string { return ChargePointPlaceType }
+
+// PlaceType returns TaxiRankPlaceType
+func (TaxiRankDetails) PlaceType() string { return TaxiRankPlaceType }
+
+// PlaceType returns CoachBayPlaceType
+func (CoachBayDetails) PlaceType() string { return CoachBayPlaceType }
+
+// PlaceType returns the type of the place the properties belong to
+func (d OtherPlaceDetails) PlaceType() string { return d.Type }
+
+// Property returns the value of the additional property with the key
+func (p Place) Property(key string) (string, bool) {
+	for _, property := range p.AdditionalProperties {
+		if strings.EqualFold(property.Key, key) {
+			return property.Value, true
+		}
+	}
+	return "", false
+}
+
+// Details returns the additional properties of the place typed according to its place type
+// Values that are missing or cannot be parsed are left as their zero value
+func (p Place) Details() PlaceDetails {
+	text := func(key string) string {
+		value, _ := p.Property(key)
+		return value
+	}
+	integer := func(key string) int {
+		value, _ := strconv.Atoi(text(key))
+		return value
+	}
+
+	switch p.PlaceType {
+	case CarParkPlaceType:
+		return CarParkDetails{
+			NumberOfSpaces:       integer("NumberOfSpaces"),
+			NumberOfDisabledBays: integer("NumberOfDisabledBays"),
+			OpeningHours:         text("OpeningHours"),
+			Operator:             text("Operator"),
+		}
+	case ChargePointPlaceType:
+		power, _ := strconv.ParseFloat(text("Power"), 64)
+		return ChargePointDetails{
+			ConnectorType: text("ConnectorType"),
+			PowerKW:       power,
+			Operator:      text("Operator"),
+			Status:        text("Status"),
+		}
+	case TaxiRankPlaceType:
+		return TaxiRankDetails{
+			NumberOfSpaces: integer("NumberOfSpaces"),
+			OperationDays:  text("OperationDays"),
+			OperationTimes: text("OperationTimes"),
+		}
+	case CoachBayPlaceType:
+		return CoachBayDetails{
+			NumberOfSpaces: integer("NumberOfSpaces"),
+			Restrictions:   text("Restrictions"),
+		}
+	}
+
+	properties := map[string]string{}
+	for _, property := range p.AdditionalProperties {
+		properties[property.Key] = property.Value
+	}
+	return OtherPlaceDetails{Type: p.PlaceType, Properties: properties}
+}
+
+// GetPlaceForID retrieves the Place for a given ID
+// It queries the endpoint /Place/{id}
+func (c *TflClient) GetPlaceForID(id string) (*Place, error) {
+
+	pathParams := []string{placePath, id}
+	url := c.buildURL(pathParams)
+
+	resp := []Place{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, errors.New("The following place is not recognised: " + id)
+	}
+
+	return &resp[0], nil
+}
+
+// GetPlacesWithinRadius retrieves the places within a radius of a coordinate
+// It queries the endpoint /Place
+func (c *TflClient) GetPlacesWithinRadius(query PlaceRadiusQuery) (*[]Place, error) {
+
+	pathParams := []string{placePath}
+	queryParams := &map[string]string{
+		"lat":    formatFloat(query.Lat),
+		"lon":    formatFloat(query.Lon),
+		"radius": formatFloat(query.Radius),
+	}
+	if len(query.Types) > 0 {
+		(*queryParams)["type"] = strings.Join(query.Types, ",")
+	}
+	if len(query.Categories) > 0 {
+		(*queryParams)["categories"] = strings.Join(query.Categories, ",")
+	}
+	return c.getPlaces(pathParams, queryParams)
+}
+
+// GetPlacesWithinBounds retrieves the places within a bounding box
+// It queries the endpoint /Place
+func (c *TflClient) GetPlacesWithinBounds(query PlaceBoundsQuery) (*[]Place, error) {
+
+	pathParams := []string{placePath}
+	queryParams := &map[string]string{
+		"placeGeo.swLat": formatFloat(query.SwLat),
+		"placeGeo.swLon": formatFloat(query.SwLon),
+		"placeGeo.neLat": formatFloat(query.NeLat),
+		"placeGeo.neLon": formatFloat(query.NeLon),
+	}
+	if len(query.Types) > 0 {
+		(*queryParams)["type"] = strings.Join(query.Types, ",")
+	}
+	return c.getPlaces(pathParams, queryParams)
+}
+
+// SearchPlaces retrieves the places whose name matches the search term, filtered against place type
+// It queries the endpoint /Place/Search
+func (c *TflClient) SearchPlaces(name string, types []string) (*[]Place, error) {
+
+	pathParams := []string{placePath, searchPath}
+	queryParams := &map[string]string{
+		"name": name,
+	}
+	if len(types) > 0 {
+		(*queryParams)["types"] = strings.Join(types, ",")
+	}
+	return c.getPlaces(pathParams, queryParams)
+}
+
+// GetPlacesByType retrieves every place of the given types
+// It queries the endpoint /Place/Type/{types}
+func (c *TflClient) GetPlacesByType(types []string, activeOnly bool) (*[]Place, error) {
+
+	pathParams := []string{placePath, typePath, strings.Join(types, ",")}
+	queryParams := &map[string]string{}
+	if activeOnly {
+		(*queryParams)["activeOnly"] = "true"
+	}
+	return c.getPlaces(pathParams, queryParams)
+}
+
+func (c *TflClient) getPlaces(pathParams []string, queryParams *map[string]string) (*[]Place, error) {
+
+	url := c.buildURLWithQueryParams(pathParams, queryParams)
+
+	resp := []Place{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,119 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetPlaceForID(t *testing.T) {
+
+	expected := []Place{}
+	json.Unmarshal(getTestDataFileContents("place.json"), &expected)
+
+	got, err := client.GetPlaceForID("CarParks_800491")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected[0], got)
+
+	_, err = client.GetPlaceForID("Unknown")
+	assert.EqualError(t, err, "The following place is not recognised: Unknown")
+}
+
+func placeIDs(places *[]Place) []string {
+	ids := []string{}
+	for _, place := range *places {
+		ids = append(ids, place.ID)
+	}
+	return ids
+}
+
+func TestTflClient_GetPlacesWithinRadius(t *testing.T) {
+
+	got, err := client.GetPlacesWithinRadius(PlaceRadiusQuery{
+		Lat:    51.5446,
+		Lon:    -0.0075,
+		Radius: 500,
+		Types:  []string{CarParkPlaceType, TaxiRankPlaceType},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CarParks_800491", "TaxiRank_5492"}, placeIDs(got))
+}
+
+func TestTflClient_GetPlacesWithinBounds(t *testing.T) {
+
+	got, err := client.GetPlacesWithinBounds(PlaceBoundsQuery{
+		SwLat: 51.49,
+		SwLon: -0.15,
+		NeLat: 51.52,
+		NeLon: -0.1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CoachBay_12", "JamCams_00001.01251"}, placeIDs(got))
+}
+
+func TestTflClient_SearchPlaces(t *testing.T) {
+
+	got, err := client.SearchPlaces("Stratford", []string{CarParkPlaceType})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CarParks_800491"}, placeIDs(got))
+}
+
+func TestTflClient_GetPlacesByType(t *testing.T) {
+
+	got, err := client.GetPlacesByType([]string{CarParkPlaceType, ChargePointPlaceType}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CarParks_800491", "ChargePointESB-UT0801-1"}, placeIDs(got))
+}
+
+func TestPlace_Details(t *testing.T) {
+
+	places := []Place{}
+	json.Unmarshal(getTestDataFileContents("places_type.json"), &places)
+	others := []Place{}
+	json.Unmarshal(getTestDataFileContents("places_bounds.json"), &others)
+	ranks := []Place{}
+	json.Unmarshal(getTestDataFileContents("places_radius.json"), &ranks)
+
+	tests := []struct {
+		name  string
+		place Place
+		want  PlaceDetails
+	}{
+		{
+			name:  "Should type car park details",
+			place: places[0],
+			want:  CarParkDetails{NumberOfSpaces: 450, NumberOfDisabledBays: 20, OpeningHours: "24 hours", Operator: "Westfield"},
+		},
+		{
+			name:  "Should type charge point details",
+			place: places[1],
+			want:  ChargePointDetails{ConnectorType: "Type 2 Mennekes", PowerKW: 22, Operator: "Source London", Status: "Available"},
+		},
+		{
+			name:  "Should type taxi rank details",
+			place: ranks[1],
+			want:  TaxiRankDetails{NumberOfSpaces: 8, OperationDays: "Mon-Sun", OperationTimes: "24 hours"},
+		},
+		{
+			name:  "Should type coach bay details",
+			place: others[0],
+			want:  CoachBayDetails{NumberOfSpaces: 1, Restrictions: "Set down only"},
+		},
+		{
+			name:  "Should keep the properties of other places",
+			place: others[1],
+			want: OtherPlaceDetails{Type: "JamCam", Properties: map[string]string{
+				"available": "true",
+				"imageUrl":  "https://s3-eu-west-1.amazonaws.com/jamcams.tfl.gov.uk/00001.01251.jpg",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.place.Details()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.place.PlaceType, got.PlaceType())
+		})
+	}
+}
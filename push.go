@@ -0,0 +1,337 @@
+package tfl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	pushAPIURL           string = "https://push-api.tfl.gov.uk/signalr"
+	predictionsHubName   string = "predictionsroomhub"
+	signalRProtocol      string = "1.5"
+	signalRTransport     string = "serverSentEvents"
+	negotiatePath        string = "negotiate"
+	connectPath          string = "connect"
+	startPath            string = "start"
+	sendPath             string = "send"
+	addLineRoomsMethod   string = "addLineRooms"
+	showPredictionMethod string = "showPredictions"
+)
+
+// PushOption is a functional option for configuring the push client
+type PushOption func(*PushClient) error
+
+// WithHubURL allows overriding of the SignalR endpoint for testing
+func WithHubURL(hubURL string) PushOption {
+	return func(p *PushClient) error {
+		parsedURL, err := url.Parse(hubURL)
+		p.hubURL = parsedURL
+		return err
+	}
+}
+
+// WithReconnectBackoff sets the shortest and longest waits between reconnection attempts
+func WithReconnectBackoff(min, max time.Duration) PushOption {
+	return func(p *PushClient) error {
+		if min <= 0 || max < min {
+			return errors.New("reconnect backoff must be positive with max no less than min")
+		}
+		p.minBackoff = min
+		p.maxBackoff = max
+		return nil
+	}
+}
+
+// PredictionRoom represents a group of the predictions hub, a line optionally narrowed to a stop
+type PredictionRoom struct {
+	LineRoom string `json:"LineRoom"`
+	NaptanID string `json:"NaptanId,omitempty"`
+}
+
+// PushClient subscribes to TfL's SignalR predictions hub using the server sent events transport
+type PushClient struct {
+	// Client is used for the long lived event stream so must not have a timeout
+	Client     *http.Client
+	hubURL     *url.URL
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu    sync.Mutex
+	rooms []PredictionRoom
+}
+
+// signalRNegotiation represents the response of the SignalR negotiate request
+type signalRNegotiation struct {
+	ConnectionToken string `json:"ConnectionToken"`
+	ConnectionID    string `json:"ConnectionId"`
+	ProtocolVersion string `json:"ProtocolVersion"`
+}
+
+// signalRMessage represents a persistent connection message carrying hub invocations
+type signalRMessage struct {
+	C string              `json:"C"`
+	M []signalRInvocation `json:"M"`
+}
+
+// signalRInvocation represents a hub method invocation in either direction
+type signalRInvocation struct {
+	H string            `json:"H"`
+	M string            `json:"M"`
+	A []json.RawMessage `json:"A"`
+	I string            `json:"I,omitempty"`
+}
+
+// NewPushClient returns a new instance of the PushClient
+func NewPushClient(opts ...PushOption) (*PushClient, error) {
+	parsedURL, _ := url.Parse(pushAPIURL)
+
+	p := &PushClient{
+		Client:     &http.Client{},
+		hubURL:     parsedURL,
+		minBackoff: time.Second,
+		maxBackoff: time.Minute,
+		rooms:      []PredictionRoom{},
+	}
+
+	for _, option := range opts {
+		if err := option(p); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// JoinLine adds every stop on a line to the subscription
+// Rooms joined while subscribed take effect on the next connection
+func (p *PushClient) JoinLine(lineID string) {
+	p.join(PredictionRoom{LineRoom: lineID})
+}
+
+// JoinStop adds a single stop on a line to the subscription
+// Rooms joined while subscribed take effect on the next connection
+func (p *PushClient) JoinStop(lineID, naptanID string) {
+	p.join(PredictionRoom{LineRoom: lineID, NaptanID: naptanID})
+}
+
+func (p *PushClient) join(room PredictionRoom) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rooms = append(p.rooms, room)
+}
+
+// Subscribe connects to the hub, joins the rooms and sends every prediction pushed to them
+// Dropped connections are retried with exponential back-off and the latest is kept on the error channel until read,
+// it need not be read for predictions to keep coming
+// Both channels are closed once the context is cancelled
+func (p *PushClient) Subscribe(ctx context.Context) (<-chan Prediction, <-chan error) {
+
+	predictions := make(chan Prediction)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(predictions)
+		defer close(errs)
+
+		wait := p.minBackoff
+		for {
+			connected, err := p.stream(ctx, predictions)
+			if ctx.Err() != nil {
+				return
+			}
+			if connected {
+				wait = p.minBackoff
+			}
+			if err != nil {
+				sendLatestError(errs, err)
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+			if wait *= 2; wait > p.maxBackoff {
+				wait = p.maxBackoff
+			}
+		}
+	}()
+
+	return predictions, errs
+}
+
+// stream runs a single connection to the hub until it drops
+// The boolean reports whether the connection was established
+func (p *PushClient) stream(ctx context.Context, predictions chan<- Prediction) (bool, error) {
+
+	negotiation := signalRNegotiation{}
+	if err := p.getJSON(ctx, p.hubEndpoint(negotiatePath, ""), &negotiation); err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.hubEndpoint(connectPath, negotiation.ConnectionToken), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("connecting to hub returned %s", resp.Status)
+	}
+
+	started := struct {
+		Response string `json:"Response"`
+	}{}
+	if err := p.getJSON(ctx, p.hubEndpoint(startPath, negotiation.ConnectionToken), &started); err != nil {
+		return false, err
+	}
+	if err := p.joinRooms(ctx, negotiation.ConnectionToken); err != nil {
+		return false, err
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	data := []string{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return true, fmt.Errorf("hub connection dropped: %v", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if strings.HasPrefix(line, "data:") {
+			data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+			continue
+		}
+		if line != "" || len(data) == 0 {
+			continue
+		}
+
+		decoded, err := decodePredictionMessage([]byte(strings.Join(data, "\n")))
+		data = data[:0]
+		if err != nil {
+			return true, err
+		}
+		for _, prediction := range decoded {
+			select {
+			case predictions <- prediction:
+			case <-ctx.Done():
+				return true, ctx.Err()
+			}
+		}
+	}
+}
+
+// decodePredictionMessage decodes the predictions pushed in an event, ignoring keep alives and other methods
+func decodePredictionMessage(data []byte) ([]Prediction, error) {
+
+	predictions := []Prediction{}
+	if !bytes.HasPrefix(data, []byte("{")) {
+		return predictions, nil
+	}
+
+	message := signalRMessage{}
+	if err := json.Unmarshal(data, &message); err != nil {
+		return nil, err
+	}
+	for _, invocation := range message.M {
+		if !strings.EqualFold(invocation.M, showPredictionMethod) {
+			continue
+		}
+		for _, arg := range invocation.A {
+			pushed := []Prediction{}
+			if err := json.Unmarshal(arg, &pushed); err != nil {
+				return nil, err
+			}
+			predictions = append(predictions, pushed...)
+		}
+	}
+	return predictions, nil
+}
+
+// joinRooms asks the hub to add the connection to every room
+func (p *PushClient) joinRooms(ctx context.Context, connectionToken string) error {
+
+	p.mu.Lock()
+	rooms, err := json.Marshal(p.rooms)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	invocation, err := json.Marshal(signalRInvocation{
+		H: predictionsHubName,
+		M: addLineRoomsMethod,
+		A: []json.RawMessage{rooms},
+		I: "0",
+	})
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Add("data", string(invocation))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.hubEndpoint(sendPath, connectionToken), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("joining rooms returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (p *PushClient) getJSON(ctx context.Context, endpoint string, respObj interface{}) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("%s returned %s", req.URL.Path, resp.Status)
+	}
+	return serialiseResponse(resp, respObj)
+}
+
+// hubEndpoint builds the URL of a SignalR transport request
+func (p *PushClient) hubEndpoint(path, connectionToken string) string {
+
+	endpoint := *p.hubURL
+	endpoint.Path = strings.TrimRight(endpoint.Path, "/") + "/" + path
+
+	params := url.Values{}
+	params.Add("clientProtocol", signalRProtocol)
+	params.Add("connectionData", fmt.Sprintf(`[{"name":"%s"}]`, predictionsHubName))
+	if path != negotiatePath {
+		params.Add("transport", signalRTransport)
+		params.Add("connectionToken", connectionToken)
+	}
+
+	endpoint.RawQuery = params.Encode()
+	return endpoint.String()
+}
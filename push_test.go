@@ -0,0 +1,208 @@
+package tfl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// standInHub is a local stand in for the predictions hub speaking the SignalR server sent events transport
+// Each connection pushes the predictions once the rooms are joined, connections listed in drop are then closed
+type standInHub struct {
+	mu          sync.Mutex
+	predictions []Prediction
+	drop        map[int]bool
+	connections int
+	joined      [][]PredictionRoom
+	roomsJoined chan struct{}
+}
+
+func newStandInHub(predictions []Prediction, drop ...int) *standInHub {
+	hub := &standInHub{
+		predictions: predictions,
+		drop:        map[int]bool{},
+		roomsJoined: make(chan struct{}, 10),
+	}
+	for _, connection := range drop {
+		hub.drop[connection] = true
+	}
+	return hub
+}
+
+func (h *standInHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/signalr/negotiate":
+		h.mu.Lock()
+		h.connections++
+		token := fmt.Sprintf("token-%d", h.connections)
+		h.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]string{
+			"ConnectionToken": token,
+			"ConnectionId":    token,
+			"ProtocolVersion": "1.5",
+		})
+	case "/signalr/start":
+		w.Write([]byte(`{"Response":"started"}`))
+	case "/signalr/send":
+		invocation := signalRInvocation{}
+		json.Unmarshal([]byte(r.FormValue("data")), &invocation)
+		rooms := []PredictionRoom{}
+		json.Unmarshal(invocation.A[0], &rooms)
+		h.mu.Lock()
+		h.joined = append(h.joined, rooms)
+		h.mu.Unlock()
+		w.Write([]byte(`{"I":"0"}`))
+		h.roomsJoined <- struct{}{}
+	case "/signalr/connect":
+		h.mu.Lock()
+		connection := h.connections
+		h.mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		fmt.Fprint(w, "data: initialized\n\n")
+		flusher.Flush()
+
+		select {
+		case <-h.roomsJoined:
+		case <-r.Context().Done():
+			return
+		}
+
+		args, _ := json.Marshal(h.predictions)
+		message, _ := json.Marshal(signalRMessage{
+			C: "d-1",
+			M: []signalRInvocation{{H: "PredictionsRoomHub", M: "showPredictions", A: []json.RawMessage{args}}},
+		})
+		fmt.Fprint(w, "data: {}\n\n")
+		fmt.Fprintf(w, "data: %s\n\n", message)
+		flusher.Flush()
+
+		if h.drop[connection] {
+			return
+		}
+		<-r.Context().Done()
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestPushClient_Subscribe(t *testing.T) {
+
+	pushed := []Prediction{
+		{ID: "-1401590553", VehicleID: "203", NaptanID: "940GZZLUOXC", LineID: "victoria", TimeToStation: 340},
+		{ID: "1198471025", VehicleID: "237", NaptanID: "940GZZLUOXC", LineID: "victoria", TimeToStation: 160},
+	}
+	hub := newStandInHub(pushed, 1)
+	server := httptest.NewServer(hub)
+	defer server.Close()
+
+	pushClient, err := NewPushClient(
+		WithHubURL(server.URL+"/signalr"),
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond),
+	)
+	assert.NoError(t, err)
+	pushClient.JoinLine("victoria")
+	pushClient.JoinStop("northern", "940GZZLUEUS")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	predictions, errs := pushClient.Subscribe(ctx)
+
+	assert.Equal(t, pushed[0], <-predictions)
+	assert.Equal(t, pushed[1], <-predictions)
+	assert.Contains(t, (<-errs).Error(), "hub connection dropped")
+	assert.Equal(t, pushed[0], <-predictions, "should reconnect after the connection drops")
+	assert.Equal(t, pushed[1], <-predictions)
+
+	cancel()
+	for range predictions {
+	}
+	for range errs {
+	}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	assert.Equal(t, 2, hub.connections)
+	assert.Equal(t, []PredictionRoom{
+		{LineRoom: "victoria"},
+		{LineRoom: "northern", NaptanID: "940GZZLUEUS"},
+	}, hub.joined[0])
+}
+
+func TestPushClient_Subscribe_droppedConnectionUnread(t *testing.T) {
+
+	pushed := []Prediction{{ID: "1198471025", VehicleID: "237", NaptanID: "940GZZLUOXC", LineID: "victoria", TimeToStation: 160}}
+	hub := newStandInHub(pushed, 1, 2)
+	server := httptest.NewServer(hub)
+	defer server.Close()
+
+	pushClient, err := NewPushClient(
+		WithHubURL(server.URL+"/signalr"),
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond),
+	)
+	assert.NoError(t, err)
+	pushClient.JoinLine("victoria")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	predictions, errs := pushClient.Subscribe(ctx)
+
+	// Only predictions are read, the dropped connections must not hold them up
+	for i := 0; i < 3; i++ {
+		select {
+		case prediction := <-predictions:
+			assert.Equal(t, pushed[0], prediction)
+		case <-time.After(time.Second):
+			t.Fatal("predictions stopped after a dropped connection that was not read")
+		}
+	}
+	assert.Contains(t, (<-errs).Error(), "hub connection dropped", "latest error should be kept")
+
+	cancel()
+	for range predictions {
+	}
+}
+
+func TestDecodePredictionMessage(t *testing.T) {
+
+	tests := []struct {
+		name string
+		data string
+		want []Prediction
+	}{
+		{
+			name: "Should ignore the initialized message",
+			data: "initialized",
+			want: []Prediction{},
+		},
+		{
+			name: "Should ignore keep alives",
+			data: "{}",
+			want: []Prediction{},
+		},
+		{
+			name: "Should decode pushed predictions with PascalCase fields",
+			data: `{"C":"d-1","M":[{"H":"PredictionsRoomHub","M":"showPredictions","A":[[{"Id":"1","VehicleId":"237","LineId":"victoria","TimeToStation":160}]]}]}`,
+			want: []Prediction{{ID: "1", VehicleID: "237", LineID: "victoria", TimeToStation: 160}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePredictionMessage([]byte(tt.data))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithReconnectBackoff(t *testing.T) {
+
+	_, err := NewPushClient(WithReconnectBackoff(time.Second, time.Millisecond))
+	assert.EqualError(t, err, "reconnect backoff must be positive with max no less than min")
+}
@@ -0,0 +1,200 @@
+package tfl
+
+import (
+	"errors"
+	"sort"
+)
+
+// RankingWeights sets how much each property of a journey adds to its score, lower scores rank first
+// Duration and Walking are per minute, Interchanges per change, Cost per penny and Disruption per disrupted journey
+type RankingWeights struct {
+	Duration     float64
+	Interchanges float64
+	Walking      float64
+	Cost         float64
+	Disruption   float64
+}
+
+// DefaultRankingWeights treats a change as five minutes, a walking minute as one and a half,
+// twenty pence as a minute and a disruption as fifteen minutes
+var DefaultRankingWeights = RankingWeights{
+	Duration:     1,
+	Interchanges: 5,
+	Walking:      0.5,
+	Cost:         0.05,
+	Disruption:   15,
+}
+
+// RankedJourney is a journey with the properties it was scored on
+// Index is the journey's position in the order returned by TfL
+// HasFare is false when TfL returned no fare for a journey that uses transport, its Cost is then unknown and zero
+type RankedJourney struct {
+	Journey        JourneyPlannerJourney
+	Index          int
+	Score          float64
+	Duration       int
+	Interchanges   int
+	WalkingMinutes int
+	Cost           uint16
+	HasFare        bool
+	Disrupted      bool
+}
+
+// JourneyRanking holds journeys ordered by score along with the picks a user may want instead
+// ParetoFront holds the journeys no other journey beats on both cost and time, fastest first
+// Journeys without a fare are left out of ParetoFront and Cheapest, which is nil if no journey has a fare
+type JourneyRanking struct {
+	Ranked        []RankedJourney
+	ParetoFront   []RankedJourney
+	Fastest       *RankedJourney
+	Cheapest      *RankedJourney
+	FewestChanges *RankedJourney
+}
+
+// Rank scores the itinerary's journeys with the weights
+func (r JourneyPlannerItineraryResult) Rank(weights RankingWeights) (*JourneyRanking, error) {
+	return RankJourneys(r.Journeys, weights)
+}
+
+// RankJourneys scores the journeys with the weights and orders them best first, ties keep TfL's order
+// Journeys without a fare are scored as costing as much as the dearest journey with one
+func RankJourneys(journeys []JourneyPlannerJourney, weights RankingWeights) (*JourneyRanking, error) {
+
+	if weights.Duration < 0 || weights.Interchanges < 0 || weights.Walking < 0 || weights.Cost < 0 || weights.Disruption < 0 {
+		return nil, errors.New("ranking weights must not be negative")
+	}
+
+	ranking := &JourneyRanking{
+		Ranked:      []RankedJourney{},
+		ParetoFront: []RankedJourney{},
+	}
+	if len(journeys) == 0 {
+		return ranking, nil
+	}
+
+	var dearest uint16
+	for i, journey := range journeys {
+		ranked := rankJourney(i, journey)
+		if ranked.HasFare && ranked.Cost > dearest {
+			dearest = ranked.Cost
+		}
+		ranking.Ranked = append(ranking.Ranked, ranked)
+	}
+	for i := range ranking.Ranked {
+		ranking.Ranked[i].Score = score(ranking.Ranked[i], weights, dearest)
+	}
+	sort.SliceStable(ranking.Ranked, func(i, j int) bool {
+		return ranking.Ranked[i].Score < ranking.Ranked[j].Score
+	})
+
+	ranking.Fastest = bestJourney(ranking.Ranked, func(a, b RankedJourney) bool {
+		return a.Duration < b.Duration
+	})
+	ranking.Cheapest = bestJourney(withFares(ranking.Ranked), func(a, b RankedJourney) bool {
+		return a.Cost < b.Cost || (a.Cost == b.Cost && a.Duration < b.Duration)
+	})
+	ranking.FewestChanges = bestJourney(ranking.Ranked, func(a, b RankedJourney) bool {
+		return a.Interchanges < b.Interchanges || (a.Interchanges == b.Interchanges && a.Duration < b.Duration)
+	})
+	ranking.ParetoFront = paretoFront(withFares(ranking.Ranked))
+
+	return ranking, nil
+}
+
+func rankJourney(index int, journey JourneyPlannerJourney) RankedJourney {
+
+	ranked := RankedJourney{
+		Journey:  journey,
+		Index:    index,
+		Duration: int(journey.Duration),
+		Cost:     journey.Fare.TotalCost,
+	}
+
+	transportLegs := 0
+	for _, leg := range journey.Legs {
+		if leg.Mode.ID == "walking" {
+			ranked.WalkingMinutes += int(leg.Duration)
+		} else {
+			transportLegs++
+		}
+		if leg.IsDisrupted {
+			ranked.Disrupted = true
+		}
+	}
+	if transportLegs > 1 {
+		ranked.Interchanges = transportLegs - 1
+	}
+
+	// Walking is free, otherwise a journey with neither a total nor any fares has no fare data
+	ranked.HasFare = transportLegs == 0 || journey.Fare.TotalCost > 0 || len(journey.Fare.Fares) > 0
+	return ranked
+}
+
+// score weighs the journey's properties, using unknownCost for a journey without a fare
+func score(ranked RankedJourney, weights RankingWeights, unknownCost uint16) float64 {
+	cost := ranked.Cost
+	if !ranked.HasFare {
+		cost = unknownCost
+	}
+	total := weights.Duration*float64(ranked.Duration) +
+		weights.Interchanges*float64(ranked.Interchanges) +
+		weights.Walking*float64(ranked.WalkingMinutes) +
+		weights.Cost*float64(cost)
+	if ranked.Disrupted {
+		total += weights.Disruption
+	}
+	return total
+}
+
+// withFares returns the journeys whose fare is known
+func withFares(journeys []RankedJourney) []RankedJourney {
+	known := []RankedJourney{}
+	for _, journey := range journeys {
+		if journey.HasFare {
+			known = append(known, journey)
+		}
+	}
+	return known
+}
+
+// bestJourney returns the first journey that no later journey is better than
+// As journeys are in ranked order, ties go to the better score
+func bestJourney(journeys []RankedJourney, better func(a, b RankedJourney) bool) *RankedJourney {
+	if len(journeys) == 0 {
+		return nil
+	}
+	best := journeys[0]
+	for _, journey := range journeys[1:] {
+		if better(journey, best) {
+			best = journey
+		}
+	}
+	return &best
+}
+
+// paretoFront returns the journeys not dominated on cost and duration, ordered fastest first
+func paretoFront(journeys []RankedJourney) []RankedJourney {
+
+	front := []RankedJourney{}
+	for _, candidate := range journeys {
+		dominated := false
+		for _, other := range journeys {
+			if other.Cost <= candidate.Cost && other.Duration <= candidate.Duration &&
+				(other.Cost < candidate.Cost || other.Duration < candidate.Duration) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			front = append(front, candidate)
+		}
+	}
+
+	sort.SliceStable(front, func(i, j int) bool {
+		if front[i].Duration != front[j].Duration {
+			return front[i].Duration < front[j].Duration
+		}
+		return front[i].Cost < front[j].Cost
+	})
+	return front
+}
@@ -0,0 +1,112 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rankingLeg(mode string, duration uint16, disrupted bool) Leg {
+	return Leg{Duration: duration, Mode: LineIdentifier{ID: mode}, IsDisrupted: disrupted}
+}
+
+func rankingJourneys() []JourneyPlannerJourney {
+	return []JourneyPlannerJourney{
+		{
+			Duration: 40,
+			Legs:     []Leg{rankingLeg("tube", 20, false), rankingLeg("walking", 5, false), rankingLeg("tube", 15, false)},
+			Fare:     JourneyFare{TotalCost: 240},
+		},
+		{
+			Duration: 30,
+			Legs:     []Leg{rankingLeg("tube", 10, false), rankingLeg("tube", 10, true), rankingLeg("tube", 10, false)},
+			Fare:     JourneyFare{TotalCost: 310},
+		},
+		{
+			Duration: 55,
+			Legs:     []Leg{rankingLeg("walking", 10, false), rankingLeg("bus", 45, false)},
+			Fare:     JourneyFare{TotalCost: 155},
+		},
+		{
+			Duration: 45,
+			Legs:     []Leg{rankingLeg("tube", 25, false), rankingLeg("tube", 20, false)},
+			Fare:     JourneyFare{TotalCost: 310},
+		},
+	}
+}
+
+func rankedIndexes(journeys []RankedJourney) []int {
+	indexes := []int{}
+	for _, journey := range journeys {
+		indexes = append(indexes, journey.Index)
+	}
+	return indexes
+}
+
+func TestRankJourneys(t *testing.T) {
+
+	got, err := RankJourneys(rankingJourneys(), DefaultRankingWeights)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{0, 3, 2, 1}, rankedIndexes(got.Ranked))
+	assert.Equal(t, 59.5, got.Ranked[0].Score)
+	assert.Equal(t, 1, got.Ranked[0].Interchanges)
+	assert.Equal(t, 5, got.Ranked[0].WalkingMinutes)
+	assert.Equal(t, 70.5, got.Ranked[3].Score)
+	assert.True(t, got.Ranked[3].Disrupted)
+
+	assert.Equal(t, []int{1, 0, 2}, rankedIndexes(got.ParetoFront))
+	assert.Equal(t, 1, got.Fastest.Index)
+	assert.Equal(t, 2, got.Cheapest.Index)
+	assert.Equal(t, 2, got.FewestChanges.Index)
+}
+
+func TestRankJourneys_weights(t *testing.T) {
+
+	got, err := JourneyPlannerItineraryResult{Journeys: rankingJourneys()}.Rank(RankingWeights{Duration: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0, 3, 2}, rankedIndexes(got.Ranked))
+
+	got, err = RankJourneys(rankingJourneys(), RankingWeights{Cost: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 0, 1, 3}, rankedIndexes(got.Ranked))
+
+	_, err = RankJourneys(rankingJourneys(), RankingWeights{Duration: -1})
+	assert.EqualError(t, err, "ranking weights must not be negative")
+}
+
+func TestRankJourneys_empty(t *testing.T) {
+
+	got, err := RankJourneys([]JourneyPlannerJourney{}, DefaultRankingWeights)
+	assert.NoError(t, err)
+	assert.Empty(t, got.Ranked)
+	assert.Empty(t, got.ParetoFront)
+	assert.Nil(t, got.Fastest)
+}
+
+func TestRankJourneys_noFare(t *testing.T) {
+
+	itinerary := JourneyPlannerItineraryResult{}
+	json.Unmarshal(getTestDataFileContents("journey_no_fare.json"), &itinerary)
+
+	got, err := itinerary.Rank(DefaultRankingWeights)
+	assert.NoError(t, err)
+	assert.Len(t, got.Ranked, 3)
+	for _, journey := range got.Ranked {
+		assert.False(t, journey.HasFare)
+	}
+	assert.Nil(t, got.Cheapest)
+	assert.Empty(t, got.ParetoFront)
+	assert.Equal(t, 33, got.Fastest.Duration)
+
+	// A priced journey is the only one that can be cheapest, the others are scored at its cost
+	journeys := append(itinerary.Journeys, rankingJourneys()[0])
+	got, err = RankJourneys(journeys, RankingWeights{Cost: 1})
+	assert.NoError(t, err)
+	for _, journey := range got.Ranked {
+		assert.Equal(t, 240.0, journey.Score)
+	}
+	assert.Equal(t, 3, got.Cheapest.Index)
+	assert.Equal(t, []int{3}, rankedIndexes(got.ParetoFront))
+}
@@ -0,0 +1,52 @@
+package tfl
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter hands out evenly spaced slots for requests, shared by every goroutine using the client
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimiter(requests int, period time.Duration) *rateLimiter {
+	return &rateLimiter{interval: period / time.Duration(requests)}
+}
+
+// wait blocks until the caller's slot arrives or the context is cancelled
+// A cancelled caller gives its slot up so later callers are not held back
+func (l *rateLimiter) wait(ctx context.Context) error {
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	slot := l.next
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		if l.next.Equal(slot.Add(l.interval)) {
+			l.next = slot
+		}
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
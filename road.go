@@ -0,0 +1,175 @@
+package tfl
+
+import (
+	"strings"
+	"time"
+)
+
+// RoadStatusQuery is used to hold the data for querying GetRoadStatus
+// An empty IDs returns every road, zero times leave the date range open
+type RoadStatusQuery struct {
+	IDs        []string
+	From, To   time.Time
+	Severities []string
+}
+
+// RoadDisruptionQuery is used to hold the data for querying GetRoadDisruptions
+// An empty IDs returns disruptions on every road, zero times leave the date range open
+type RoadDisruptionQuery struct {
+	IDs          []string
+	From, To     time.Time
+	Severities   []string
+	StripContent bool
+}
+
+// BoundsCoordinates returns the south west and north east corners of the corridor
+func (r RoadCorridor) BoundsCoordinates() ([]Coordinate, error) {
+	return parseLonLatPairs([]byte(r.Bounds))
+}
+
+// EnvelopeCoordinates returns the polygon enclosing the corridor
+func (r RoadCorridor) EnvelopeCoordinates() ([]Coordinate, error) {
+	return parseLonLatPairs([]byte(r.Envelope))
+}
+
+// PointCoordinate returns the location of the disruption
+func (d RoadDisruption) PointCoordinate() (Coordinate, error) {
+	coordinates, err := parseLonLatPairs([]byte(d.Point))
+	if err != nil || len(coordinates) == 0 {
+		return Coordinate{}, err
+	}
+	return coordinates[0], nil
+}
+
+// StartTime returns the time the disruption starts
+func (d RoadDisruption) StartTime() (time.Time, error) {
+	return parseTflTime(d.StartDateTime)
+}
+
+// EndTime returns the time the disruption ends
+func (d RoadDisruption) EndTime() (time.Time, error) {
+	return parseTflTime(d.EndDateTime)
+}
+
+// Points returns every coordinate of the geometry in order
+func (g RoadGeography) Points() ([]Coordinate, error) {
+	return parseLonLatPairs(g.Coordinates)
+}
+
+// Points returns the coordinates of the street segment
+func (s StreetSegment) Points() ([]Coordinate, error) {
+	return parseLonLatPairs([]byte(s.LineString))
+}
+
+// GetRoads retrieves every road corridor managed by TfL
+// It queries the endpoint /Road
+func (c *TflClient) GetRoads() (*[]RoadCorridor, error) {
+
+	pathParams := []string{roadPath}
+	url := c.buildURL(pathParams)
+
+	resp := []RoadCorridor{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetRoadStatus retrieves the status of road corridors, filtered against their severity
+// It queries the endpoint /Road/{ids}/Status
+func (c *TflClient) GetRoadStatus(query RoadStatusQuery) (*[]RoadCorridor, error) {
+
+	pathParams := []string{roadPath, roadIDs(query.IDs), statusPath}
+	queryParams := &map[string]string{}
+	addDateRange(queryParams, query.From, query.To)
+	url := c.buildURLWithQueryParams(pathParams, queryParams)
+
+	resp := []RoadCorridor{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	corridors := []RoadCorridor{}
+	for _, corridor := range resp {
+		if matchesSeverity(corridor.StatusSeverity, query.Severities) {
+			corridors = append(corridors, corridor)
+		}
+	}
+
+	return &corridors, nil
+}
+
+// GetRoadDisruptions retrieves the disruptions on road corridors
+// filtered against their severity and whether they are active within the date range
+// It queries the endpoint /Road/{ids}/Disruption
+func (c *TflClient) GetRoadDisruptions(query RoadDisruptionQuery) (*[]RoadDisruption, error) {
+
+	pathParams := []string{roadPath, roadIDs(query.IDs), disruptionPath}
+	queryParams := &map[string]string{}
+	if len(query.Severities) > 0 {
+		(*queryParams)["severities"] = strings.Join(query.Severities, ",")
+	}
+	if query.StripContent {
+		(*queryParams)["stripContent"] = "true"
+	}
+	url := c.buildURLWithQueryParams(pathParams, queryParams)
+
+	resp := []RoadDisruption{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	disruptions := []RoadDisruption{}
+	for _, disruption := range resp {
+		if !matchesSeverity(disruption.Severity, query.Severities) {
+			continue
+		}
+		if !activeWithin(disruption, query.From, query.To) {
+			continue
+		}
+		disruptions = append(disruptions, disruption)
+	}
+
+	return &disruptions, nil
+}
+
+func roadIDs(ids []string) string {
+	if len(ids) == 0 {
+		return "all"
+	}
+	return strings.Join(ids, ",")
+}
+
+func addDateRange(queryParams *map[string]string, from, to time.Time) {
+	if !from.IsZero() {
+		(*queryParams)["dateRangeNullable.startDate"] = from.Format(tflTimeLayout)
+	}
+	if !to.IsZero() {
+		(*queryParams)["dateRangeNullable.endDate"] = to.Format(tflTimeLayout)
+	}
+}
+
+func matchesSeverity(severity string, severities []string) bool {
+	if len(severities) == 0 {
+		return true
+	}
+	for _, wanted := range severities {
+		if strings.EqualFold(severity, wanted) {
+			return true
+		}
+	}
+	return false
+}
+
+// activeWithin reports whether the disruption overlaps the date range
+// Disruptions without a start or end time are treated as open ended
+func activeWithin(disruption RoadDisruption, from, to time.Time) bool {
+	if start, err := disruption.StartTime(); err == nil && !to.IsZero() && start.After(to) {
+		return false
+	}
+	if end, err := disruption.EndTime(); err == nil && !from.IsZero() && end.Before(from) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,141 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetRoads(t *testing.T) {
+
+	expected := []RoadCorridor{}
+	json.Unmarshal(getTestDataFileContents("roads.json"), &expected)
+
+	got, err := client.GetRoads()
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+
+	bounds, err := (*got)[0].BoundsCoordinates()
+	assert.NoError(t, err)
+	assert.Equal(t, []Coordinate{{Lat: 51.5319, Lon: -0.25616}, {Lat: 51.6562, Lon: -0.10234}}, bounds)
+}
+
+func TestTflClient_GetRoadStatus(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		query RoadStatusQuery
+		want  []string
+	}{
+		{
+			name: "Should retrieve road status for a date range",
+			query: RoadStatusQuery{
+				IDs:  []string{"a1", "a2"},
+				From: at("2020-08-22T00:00:00"),
+				To:   at("2020-08-23T00:00:00"),
+			},
+			want: []string{"a1", "a2"},
+		},
+		{
+			name: "Should filter road status by severity",
+			query: RoadStatusQuery{
+				IDs:        []string{"a1", "a2"},
+				From:       at("2020-08-22T00:00:00"),
+				To:         at("2020-08-23T00:00:00"),
+				Severities: []string{"serious"},
+			},
+			want: []string{"a2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetRoadStatus(tt.query)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, corridor := range *got {
+				ids = append(ids, corridor.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestTflClient_GetRoadDisruptions(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		query   RoadDisruptionQuery
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "Should retrieve all road disruptions",
+			query: RoadDisruptionQuery{},
+			want:  []string{"TIMS-1001", "TIMS-1002", "TIMS-1003"},
+		},
+		{
+			name: "Should filter road disruptions by date range",
+			query: RoadDisruptionQuery{
+				From: at("2020-08-22T00:00:00"),
+				To:   at("2020-08-31T00:00:00"),
+			},
+			want: []string{"TIMS-1001"},
+		},
+		{
+			name: "Should filter road disruptions by severity",
+			query: RoadDisruptionQuery{
+				Severities: []string{"Minimal"},
+			},
+			want: []string{"TIMS-1002"},
+		},
+		{
+			name:    "Should handle response for invalid ID",
+			query:   RoadDisruptionQuery{IDs: []string{"INVALID"}},
+			wantErr: "The following road id is not recognised: INVALID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetRoadDisruptions(tt.query)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, disruption := range *got {
+				ids = append(ids, disruption.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestRoadDisruption_Geography(t *testing.T) {
+
+	disruptions := []RoadDisruption{}
+	json.Unmarshal(getTestDataFileContents("road_disruptions.json"), &disruptions)
+	disruption := disruptions[0]
+
+	point, err := disruption.PointCoordinate()
+	assert.NoError(t, err)
+	assert.Equal(t, Coordinate{Lat: 51.4901, Lon: -0.0742}, point)
+
+	geography, err := disruption.Geography.Points()
+	assert.NoError(t, err)
+	assert.Equal(t, []Coordinate{point}, geography)
+
+	geometry, err := disruption.Geometry.Points()
+	assert.NoError(t, err)
+	assert.Len(t, geometry, 4)
+
+	segment, err := disruption.Streets[0].Segments[0].Points()
+	assert.NoError(t, err)
+	assert.Equal(t, []Coordinate{{Lat: 51.4901, Lon: -0.0742}, {Lat: 51.4898, Lon: -0.0738}}, segment)
+
+	start, err := disruption.StartTime()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 8, 22, 5, 0, 0, 0, time.UTC), start)
+}
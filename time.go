@@ -0,0 +1,42 @@
+package tfl
+
+import (
+	"sync"
+	"time"
+)
+
+// tflTimeLayout is the layout used by the API for date times, e.g. 2019-04-01T07:04:00
+const tflTimeLayout string = "2006-01-02T15:04:05"
+
+// parseTflTime parses a date time returned by the API
+// The API returns London local times without an offset, so the wall clock value is preserved in UTC
+// Date times that do carry an offset, e.g. 2020-08-22T05:00:00Z, are parsed as RFC 3339
+func parseTflTime(value string) (time.Time, error) {
+	return parseTflTimeIn(value, time.UTC)
+}
+
+// parseTflTimeIn parses a date time returned by the API, taking those without an offset to be in loc
+func parseTflTimeIn(value string, loc *time.Location) (time.Time, error) {
+	parsed, err := time.ParseInLocation(tflTimeLayout, value, loc)
+	if err == nil {
+		return parsed, nil
+	}
+	if parsed, rfcErr := time.Parse(time.RFC3339Nano, value); rfcErr == nil {
+		return parsed, nil
+	}
+	return time.Time{}, err
+}
+
+var (
+	londonOnce     sync.Once
+	londonLocation *time.Location
+	londonErr      error
+)
+
+// loadLondon returns the Europe/London location the API's local times are in
+func loadLondon() (*time.Location, error) {
+	londonOnce.Do(func() {
+		londonLocation, londonErr = time.LoadLocation("Europe/London")
+	})
+	return londonLocation, londonErr
+}
@@ -0,0 +1,186 @@
+package tfl
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DayType is the name TfL gives to the days a Schedule runs on
+type DayType string
+
+// The day types used by most timetables
+const (
+	MondayToFriday DayType = "Monday - Friday"
+	Saturday       DayType = "Saturday"
+	Sunday         DayType = "Sunday"
+)
+
+// timetableSearchDays is how many days ahead NextDepartures looks for departures
+const timetableSearchDays = 7
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ScheduledDeparture is a departure from a Timetable's departure stop
+type ScheduledDeparture struct {
+	Time         time.Time
+	ScheduleName string
+	IntervalID   int
+}
+
+// DayType returns the DayType of the schedule
+func (s Schedule) DayType() DayType {
+	return DayType(s.Name)
+}
+
+// Weekdays returns the days of the week the schedule runs on, parsed from its name
+// e.g. "Monday - Friday" or "Saturday (also Good Friday)"
+// Night schedules and names that are not recognised run on no days
+func (s Schedule) Weekdays() []time.Weekday {
+	name := strings.ToLower(s.Name)
+	if i := strings.Index(name, "("); i >= 0 {
+		name = name[:i]
+	}
+	if strings.Contains(name, "night") {
+		return []time.Weekday{}
+	}
+
+	parts := strings.Split(name, "-")
+	first, ok := weekdayNames[strings.TrimSpace(parts[0])]
+	if !ok {
+		return []time.Weekday{}
+	}
+	if len(parts) == 1 {
+		return []time.Weekday{first}
+	}
+	last, ok := weekdayNames[strings.TrimSpace(parts[1])]
+	if !ok {
+		return []time.Weekday{}
+	}
+
+	weekdays := []time.Weekday{first}
+	for day := first; day != last; {
+		day = (day + 1) % 7
+		weekdays = append(weekdays, day)
+	}
+	return weekdays
+}
+
+// RunsOn reports whether the schedule runs on the day of the week
+func (s Schedule) RunsOn(weekday time.Weekday) bool {
+	for _, day := range s.Weekdays() {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// TimeOfDay returns the time after midnight the journey departs
+// Journeys after midnight can have hours of 24 or more as they belong to the previous day's service
+func (k KnownJourney) TimeOfDay() (time.Duration, error) {
+	return clockTime(k.Hour, k.Minute)
+}
+
+// TimeOfDay returns the time after midnight of the clock time
+func (t TwentyFourHourClockTime) TimeOfDay() (time.Duration, error) {
+	return clockTime(t.Hour, t.Minute)
+}
+
+func clockTime(hour, minute string) (time.Duration, error) {
+	h, err := strconv.Atoi(hour)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.Atoi(minute)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// NextDepartures returns the next n scheduled departures at or after the given time, in time order
+// There are no departures to return when n is not positive
+func (t TimetableResponse) NextDepartures(after time.Time, n int) ([]ScheduledDeparture, error) {
+
+	if n <= 0 {
+		return []ScheduledDeparture{}, nil
+	}
+
+	midnight := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, after.Location())
+	departures := []ScheduledDeparture{}
+
+	// Start from the previous day to pick up its journeys that run past midnight
+	for offset := -1; offset <= timetableSearchDays; offset++ {
+		day := midnight.AddDate(0, 0, offset)
+		for _, route := range t.Timetable.Routes {
+			for _, schedule := range route.Schedules {
+				if !schedule.RunsOn(day.Weekday()) {
+					continue
+				}
+				for _, journey := range schedule.KnownJourneys {
+					timeOfDay, err := journey.TimeOfDay()
+					if err != nil {
+						return nil, err
+					}
+					// Build the clock time on the day rather than adding elapsed time, which is out by an hour when the clocks change
+					hour, minute := int(timeOfDay/time.Hour), int(timeOfDay%time.Hour/time.Minute)
+					departure := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+					if departure.Before(after) {
+						continue
+					}
+					departures = append(departures, ScheduledDeparture{
+						Time:         departure,
+						ScheduleName: schedule.Name,
+						IntervalID:   journey.IntervalID,
+					})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(departures, func(i, j int) bool {
+		return departures[i].Time.Before(departures[j].Time)
+	})
+	if len(departures) > n {
+		departures = departures[:n]
+	}
+	return departures, nil
+}
+
+// GetTimetable retrieves the scheduled departures of a line from a stop
+// It queries the endpoint /Line/{id}/Timetable/{fromStopPointId}
+func (c *TflClient) GetTimetable(lineID, fromStopPointID string) (*TimetableResponse, error) {
+
+	pathParams := []string{linePath, lineID, timetablePath, fromStopPointID}
+	return c.getTimetable(pathParams)
+}
+
+// GetTimetableTo retrieves the scheduled departures of a line from a stop towards another stop
+// It queries the endpoint /Line/{id}/Timetable/{fromStopPointId}/to/{toStopPointId}
+func (c *TflClient) GetTimetableTo(lineID, fromStopPointID, toStopPointID string) (*TimetableResponse, error) {
+
+	pathParams := []string{linePath, lineID, timetablePath, fromStopPointID, toPath, toStopPointID}
+	return c.getTimetable(pathParams)
+}
+
+func (c *TflClient) getTimetable(pathParams []string) (*TimetableResponse, error) {
+
+	url := c.buildURL(pathParams)
+
+	resp := TimetableResponse{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,126 @@
+package tfl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetTimetable(t *testing.T) {
+
+	expected := TimetableResponse{}
+	json.Unmarshal(getTestDataFileContents("timetable.json"), &expected)
+
+	got, err := client.GetTimetable("victoria", "940GZZLUVIC")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+
+	got, err = client.GetTimetableTo("victoria", "940GZZLUVIC", "940GZZLUOXC")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestSchedule_Weekdays(t *testing.T) {
+
+	tests := []struct {
+		name string
+		want []time.Weekday
+	}{
+		{
+			name: string(MondayToFriday),
+			want: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		},
+		{
+			name: "Saturday (also Good Friday)",
+			want: []time.Weekday{time.Saturday},
+		},
+		{
+			name: string(Sunday),
+			want: []time.Weekday{time.Sunday},
+		},
+		{
+			name: "Friday - Sunday",
+			want: []time.Weekday{time.Friday, time.Saturday, time.Sunday},
+		},
+		{
+			name: "Friday Night",
+			want: []time.Weekday{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Schedule{Name: tt.name}.Weekdays())
+		})
+	}
+}
+
+func TestTimetableResponse_NextDepartures(t *testing.T) {
+
+	timetable := TimetableResponse{}
+	json.Unmarshal(getTestDataFileContents("timetable.json"), &timetable)
+
+	tests := []struct {
+		name  string
+		after time.Time
+		n     int
+		want  []time.Time
+	}{
+		{
+			name:  "Should retrieve departures later the same day",
+			after: at("2020-08-17T05:31:00"),
+			n:     2,
+			want:  []time.Time{at("2020-08-17T05:45:00"), at("2020-08-17T23:50:00")},
+		},
+		{
+			name:  "Should retrieve departures after midnight and across day types",
+			after: at("2020-08-21T23:55:00"),
+			n:     3,
+			want:  []time.Time{at("2020-08-22T00:10:00"), at("2020-08-22T06:00:00"), at("2020-08-23T00:20:00")},
+		},
+		{
+			name:  "Should include the previous day's journeys running past midnight",
+			after: at("2020-08-18T00:05:00"),
+			n:     1,
+			want:  []time.Time{at("2020-08-18T00:10:00")},
+		},
+		{
+			name:  "Should retrieve no departures when n is negative",
+			after: at("2020-08-17T05:31:00"),
+			n:     -1,
+			want:  []time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timetable.NextDepartures(tt.after, tt.n)
+			assert.NoError(t, err)
+			times := []time.Time{}
+			for _, departure := range got {
+				times = append(times, departure.Time)
+			}
+			assert.Equal(t, tt.want, times)
+		})
+	}
+}
+
+func TestTimetableResponse_NextDepartures_clocksChange(t *testing.T) {
+
+	timetable := TimetableResponse{}
+	json.Unmarshal(getTestDataFileContents("timetable.json"), &timetable)
+	london := londonTime(t)
+
+	// The clocks went forward at 01:00 on Sunday 29 March 2020
+	got, err := timetable.NextDepartures(time.Date(2020, 3, 29, 0, 0, 0, 0, london), 3)
+	assert.NoError(t, err)
+	times := []time.Time{}
+	for _, departure := range got {
+		times = append(times, departure.Time)
+	}
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 3, 29, 0, 20, 0, 0, london),
+		time.Date(2020, 3, 29, 7, 0, 0, 0, london),
+		time.Date(2020, 3, 29, 23, 30, 0, 0, london),
+	}, times)
+}
@@ -1,5 +1,7 @@
 package tfl
 
+import "encoding/json"
+
 // APIErrorResponse represents Tfl.Api.Presentation.Entities.ApiError
 type APIErrorResponse struct {
 	TimestampUTC   string `json:"timestampUTC"`
@@ -28,8 +30,8 @@ type StopPointAPIResponse struct {
 	PlaceType            string                 `json:"placeType"`
 	AdditionalProperties []AdditionalProperties `json:"additionalProperties"`
 	Children             []StopPointAPIResponse `json:"children"`
-	// Lat                  float64          `json:"lat"`
-	// Lon                  float64          `json:"lon"`
+	Lat                  float64                `json:"lat"`
+	Lon                  float64                `json:"lon"`
 }
 
 type AdditionalProperties struct {
@@ -42,23 +44,51 @@ type AdditionalProperties struct {
 
 // LineIdentifier represents
 type LineIdentifier struct {
-	RespType  string `json:"$type"`
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	URI       string `json:"uri"`
-	Type      string `json:"type"`
-	Crowding  string `json:"crowding"` // TODO define this as a struct
-	RouteType string `json:"routeType"`
-	Status    string `json:"status"`
+	RespType  string   `json:"$type"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	URI       string   `json:"uri"`
+	Type      string   `json:"type"`
+	Crowding  Crowding `json:"crowding"`
+	RouteType string   `json:"routeType"`
+	Status    string   `json:"status"`
+}
+
+// Crowding represents Tfl.Api.Presentation.Entities.Crowding
+type Crowding struct {
+	PassengerFlows []PassengerFlow `json:"passengerFlows"`
+	TrainLoadings  []TrainLoading  `json:"trainLoadings"`
+}
+
+// PassengerFlow represents Tfl.Api.Presentation.Entities.PassengerFlow
+type PassengerFlow struct {
+	TimeSlice string `json:"timeSlice"`
+	Value     int    `json:"value"`
+}
+
+// TrainLoading represents Tfl.Api.Presentation.Entities.TrainLoading
+type TrainLoading struct {
+	Line              string `json:"line"`
+	LineDirection     string `json:"lineDirection"`
+	PlatformDirection string `json:"platformDirection"`
+	Direction         string `json:"direction"`
+	NaptanTo          string `json:"naptanTo"`
+	TimeSlice         string `json:"timeSlice"`
+	Value             int    `json:"value"`
 }
 
 // EntityMatchedStop represents Tfl.Api.Presentation.Entities.MatchedStop
 type EntityMatchedStop struct {
-	Modes   []string `json:"modes"`
-	IcsCode string   `json:"icsId"`
-	Name    string   `json:"name"`
-	Zone    string   `json:"zone"`
-	ID      string   `json:"id"`
+	Modes           []string `json:"modes"`
+	IcsCode         string   `json:"icsId"`
+	Name            string   `json:"name"`
+	Zone            string   `json:"zone"`
+	ID              string   `json:"id"`
+	StationID       string   `json:"stationId"`
+	TopMostParentID string   `json:"topMostParentId"`
+	ParentID        string   `json:"parentId"`
+	Lat             float64  `json:"lat"`
+	Lon             float64  `json:"lon"`
 }
 
 // EntitySearchResponse represents Tfl.Api.Presentation.Entities.SearchResponse
@@ -69,8 +99,14 @@ type EntitySearchResponse struct {
 // JourneyPlannerQuery is used to hold the data for querying JourneyPlannerItinerary
 // TODO change dateTime from string to time.Time?
 type JourneyPlannerQuery struct {
-	From, To, Date, Time string
-	Modes                []string
+	From, To        Location
+	Date, Time      string
+	Modes           []string
+	CyclePreference CyclePreference
+	BikeProficiency BikeProficiency
+	StepFree        StepFreeLevel
+	AvoidEscalators bool
+	TimeIs          TimeIs
 }
 
 // JourneyPlannerItineraryResult represents Tfl.Api.Presentation.Entities.JourneyPlanner.ItineraryResult
@@ -95,6 +131,27 @@ type Leg struct {
 	ArrivalTime    string               `json:"arrivalTime"`
 	DeparturePoint StopPointAPIResponse `json:"departurePoint"`
 	ArrivalPoint   StopPointAPIResponse `json:"arrivalPoint"`
+	Mode           LineIdentifier       `json:"mode"`
+	Distance       float64              `json:"distance"`
+	Path           LegPath              `json:"path"`
+	IsDisrupted    bool                 `json:"isDisrupted"`
+}
+
+// LegPath represents Tfl.Api.Presentation.Entities.JourneyPlanner.Path
+type LegPath struct {
+	LineString string        `json:"lineString"`
+	Elevation  []JpElevation `json:"elevation"`
+}
+
+// JpElevation represents Tfl.Api.Common.JourneyPlanner.JpElevation, the height change over a stretch of a leg
+type JpElevation struct {
+	Distance                int     `json:"distance"`
+	StartLat                float64 `json:"startLat"`
+	StartLon                float64 `json:"startLon"`
+	EndLat                  float64 `json:"endLat"`
+	EndLon                  float64 `json:"endLon"`
+	HeightFromPreviousPoint int     `json:"heightFromPreviousPoint"`
+	Gradient                float64 `json:"gradient"`
 }
 
 // Instruction represents Tfl.Api.Presentation.Entities.Instruction
@@ -136,7 +193,7 @@ type FareTapDetails struct {
 // FaresSection represents Tfl.Api.Presentation.Entities.Fares.FaresSection
 type FaresSection struct {
 	Header   string        `json:"header"`
-	Index    int        `json:"index"`
+	Index    int           `json:"index"`
 	Journey  FaresJourney  `json:"journey"`
 	Rows     []FareDetails `json:"rows"`
 	Messages []Message     `json:"messages"`
@@ -207,3 +264,443 @@ type TicketTime struct {
 type SingleFareFinderInput struct {
 	From, To string
 }
+
+// BikePoint represents Tfl.Api.Presentation.Entities.Place for a Santander Cycles docking station
+// The availability counts are parsed from the AdditionalProperties
+type BikePoint struct {
+	ID                   string                 `json:"id"`
+	URL                  string                 `json:"url"`
+	CommonName           string                 `json:"commonName"`
+	PlaceType            string                 `json:"placeType"`
+	Distance             float64                `json:"distance"`
+	AdditionalProperties []AdditionalProperties `json:"additionalProperties"`
+	Lat                  float64                `json:"lat"`
+	Lon                  float64                `json:"lon"`
+	TerminalName         string                 `json:"-"`
+	Installed            bool                   `json:"-"`
+	Locked               bool                   `json:"-"`
+	NbBikes              int                    `json:"-"`
+	NbStandardBikes      int                    `json:"-"`
+	NbEBikes             int                    `json:"-"`
+	NbEmptyDocks         int                    `json:"-"`
+	NbDocks              int                    `json:"-"`
+}
+
+// RoadCorridor represents Tfl.Api.Presentation.Entities.RoadCorridor
+type RoadCorridor struct {
+	ID                         string `json:"id"`
+	DisplayName                string `json:"displayName"`
+	Group                      string `json:"group"`
+	StatusSeverity             string `json:"statusSeverity"`
+	StatusSeverityDescription  string `json:"statusSeverityDescription"`
+	Bounds                     string `json:"bounds"`
+	Envelope                   string `json:"envelope"`
+	StatusAggregationStartDate string `json:"statusAggregationStartDate"`
+	StatusAggregationEndDate   string `json:"statusAggregationEndDate"`
+	URL                        string `json:"url"`
+}
+
+// RoadDisruption represents Tfl.Api.Presentation.Entities.RoadDisruption
+type RoadDisruption struct {
+	ID                 string                   `json:"id"`
+	URL                string                   `json:"url"`
+	Point              string                   `json:"point"`
+	Severity           string                   `json:"severity"`
+	Ordinal            int                      `json:"ordinal"`
+	Category           string                   `json:"category"`
+	SubCategory        string                   `json:"subCategory"`
+	Comments           string                   `json:"comments"`
+	CurrentUpdate      string                   `json:"currentUpdate"`
+	CorridorIDs        []string                 `json:"corridorIds"`
+	StartDateTime      string                   `json:"startDateTime"`
+	EndDateTime        string                   `json:"endDateTime"`
+	LastModifiedTime   string                   `json:"lastModifiedTime"`
+	LevelOfInterest    string                   `json:"levelOfInterest"`
+	Location           string                   `json:"location"`
+	Status             string                   `json:"status"`
+	Geography          RoadGeography            `json:"geography"`
+	Geometry           RoadGeography            `json:"geometry"`
+	Streets            []Street                 `json:"streets"`
+	IsProvisional      bool                     `json:"isProvisional"`
+	HasClosures        bool                     `json:"hasClosures"`
+	TimeFrame          string                   `json:"timeFrame"`
+	RecurringSchedules []RoadDisruptionSchedule `json:"recurringSchedules"`
+}
+
+// RoadGeography represents a GeoJSON geometry returned for a RoadDisruption
+type RoadGeography struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Street represents Tfl.Api.Presentation.Entities.Street
+type Street struct {
+	Name       string          `json:"name"`
+	Closure    string          `json:"closure"`
+	Directions string          `json:"directions"`
+	Segments   []StreetSegment `json:"segments"`
+}
+
+// StreetSegment represents Tfl.Api.Presentation.Entities.StreetSegment
+type StreetSegment struct {
+	Toid            string `json:"toid"`
+	LineString      string `json:"lineString"`
+	SourceSystemID  int    `json:"sourceSystemId"`
+	SourceSystemKey string `json:"sourceSystemKey"`
+}
+
+// RoadDisruptionSchedule represents Tfl.Api.Presentation.Entities.RoadDisruptionSchedule
+type RoadDisruptionSchedule struct {
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// RouteSequence represents Tfl.Api.Presentation.Entities.RouteSequence
+type RouteSequence struct {
+	LineID             string              `json:"lineId"`
+	LineName           string              `json:"lineName"`
+	Direction          string              `json:"direction"`
+	IsOutboundOnly     bool                `json:"isOutboundOnly"`
+	Mode               string              `json:"mode"`
+	LineStrings        []string            `json:"lineStrings"`
+	Stations           []EntityMatchedStop `json:"stations"`
+	StopPointSequences []StopPointSequence `json:"stopPointSequences"`
+	OrderedLineRoutes  []OrderedRoute      `json:"orderedLineRoutes"`
+}
+
+// StopPointSequence represents Tfl.Api.Presentation.Entities.StopPointSequence
+type StopPointSequence struct {
+	LineID        string              `json:"lineId"`
+	LineName      string              `json:"lineName"`
+	Direction     string              `json:"direction"`
+	BranchID      int                 `json:"branchId"`
+	NextBranchIDs []int               `json:"nextBranchIds"`
+	PrevBranchIDs []int               `json:"prevBranchIds"`
+	StopPoint     []EntityMatchedStop `json:"stopPoint"`
+	ServiceType   string              `json:"serviceType"`
+}
+
+// OrderedRoute represents Tfl.Api.Presentation.Entities.OrderedRoute
+type OrderedRoute struct {
+	Name        string   `json:"name"`
+	NaptanIDs   []string `json:"naptanIds"`
+	ServiceType string   `json:"serviceType"`
+}
+
+// TimetableResponse represents Tfl.Api.Presentation.Entities.TimetableResponse
+type TimetableResponse struct {
+	LineID             string              `json:"lineId"`
+	LineName           string              `json:"lineName"`
+	Direction          string              `json:"direction"`
+	PdfURL             string              `json:"pdfUrl"`
+	Stations           []EntityMatchedStop `json:"stations"`
+	Stops              []EntityMatchedStop `json:"stops"`
+	Timetable          Timetable           `json:"timetable"`
+	StatusErrorMessage string              `json:"statusErrorMessage"`
+}
+
+// Timetable represents Tfl.Api.Presentation.Entities.Timetable
+type Timetable struct {
+	DepartureStopID string           `json:"departureStopId"`
+	Routes          []TimetableRoute `json:"routes"`
+}
+
+// TimetableRoute represents Tfl.Api.Presentation.Entities.TimetableRoute
+type TimetableRoute struct {
+	StationIntervals []StationInterval `json:"stationIntervals"`
+	Schedules        []Schedule        `json:"schedules"`
+}
+
+// StationInterval represents Tfl.Api.Presentation.Entities.StationInterval
+type StationInterval struct {
+	ID        string     `json:"id"`
+	Intervals []Interval `json:"intervals"`
+}
+
+// Interval represents Tfl.Api.Presentation.Entities.Interval
+type Interval struct {
+	StopID        string  `json:"stopId"`
+	TimeToArrival float64 `json:"timeToArrival"`
+}
+
+// Schedule represents Tfl.Api.Presentation.Entities.Schedule
+type Schedule struct {
+	Name          string         `json:"name"`
+	KnownJourneys []KnownJourney `json:"knownJourneys"`
+	FirstJourney  KnownJourney   `json:"firstJourney"`
+	LastJourney   KnownJourney   `json:"lastJourney"`
+	Periods       []Period       `json:"periods"`
+}
+
+// KnownJourney represents Tfl.Api.Presentation.Entities.KnownJourney
+type KnownJourney struct {
+	Hour       string `json:"hour"`
+	Minute     string `json:"minute"`
+	IntervalID int    `json:"intervalId"`
+}
+
+// Period represents Tfl.Api.Presentation.Entities.Period
+type Period struct {
+	Type              string                  `json:"type"`
+	FromTime          TwentyFourHourClockTime `json:"fromTime"`
+	ToTime            TwentyFourHourClockTime `json:"toTime"`
+	FrequencySchedule ServiceFrequency        `json:"frequencySchedule"`
+}
+
+// TwentyFourHourClockTime represents Tfl.Api.Presentation.Entities.TwentyFourHourClockTime
+type TwentyFourHourClockTime struct {
+	Hour   string `json:"hour"`
+	Minute string `json:"minute"`
+}
+
+// ServiceFrequency represents Tfl.Api.Presentation.Entities.ServiceFrequency
+type ServiceFrequency struct {
+	HighestFrequency float64 `json:"highestFrequency"`
+	LowestFrequency  float64 `json:"lowestFrequency"`
+}
+
+// StationCrowding represents the typical busyness of a station across the week
+type StationCrowding struct {
+	Naptan        string        `json:"naptan"`
+	IsFound       bool          `json:"isFound"`
+	IsAlwaysQuiet bool          `json:"isAlwaysQuiet"`
+	DaysOfWeek    []CrowdingDay `json:"daysOfWeek"`
+}
+
+// StationCrowdingDay represents the typical busyness of a station on a day of the week
+type StationCrowdingDay struct {
+	Naptan        string `json:"naptan"`
+	IsFound       bool   `json:"isFound"`
+	IsAlwaysQuiet bool   `json:"isAlwaysQuiet"`
+	CrowdingDay
+}
+
+// CrowdingDay represents the busyness of a station through a day as a percentage of its busiest time band
+type CrowdingDay struct {
+	DayOfWeek      string             `json:"dayOfWeek"`
+	AmPeakTimeBand string             `json:"amPeakTimeBand"`
+	PmPeakTimeBand string             `json:"pmPeakTimeBand"`
+	TimeBands      []CrowdingTimeBand `json:"timeBands"`
+}
+
+// CrowdingTimeBand represents the busyness of a station during a time band, e.g. 08:00-08:15
+type CrowdingTimeBand struct {
+	TimeBand             string  `json:"timeBand"`
+	PercentageOfBaseLine float64 `json:"percentageOfBaseLine"`
+}
+
+// LiveCrowding represents the current busyness of a station as a percentage of its usual busiest time band
+type LiveCrowding struct {
+	DataAvailable        bool    `json:"dataAvailable"`
+	PercentageOfBaseline float64 `json:"percentageOfBaseline"`
+	TimeUTC              string  `json:"timeUtc"`
+	TimeLocal            string  `json:"timeLocal"`
+}
+
+// Disruption represents Tfl.Api.Presentation.Entities.Disruption
+type Disruption struct {
+	Category            string                 `json:"category"`
+	Type                string                 `json:"type"`
+	CategoryDescription string                 `json:"categoryDescription"`
+	Description         string                 `json:"description"`
+	Summary             string                 `json:"summary"`
+	AdditionalInfo      string                 `json:"additionalInfo"`
+	Created             string                 `json:"created"`
+	LastUpdate          string                 `json:"lastUpdate"`
+	AffectedRoutes      []RouteSection         `json:"affectedRoutes"`
+	AffectedStops       []StopPointAPIResponse `json:"affectedStops"`
+	ClosureText         string                 `json:"closureText"`
+}
+
+// RouteSection represents Tfl.Api.Presentation.Entities.RouteSection
+type RouteSection struct {
+	ID              string `json:"id"`
+	LineID          string `json:"lineId"`
+	RouteCode       string `json:"routeCode"`
+	Name            string `json:"name"`
+	Direction       string `json:"direction"`
+	OriginationName string `json:"originationName"`
+	DestinationName string `json:"destinationName"`
+	ValidFrom       string `json:"validFrom"`
+	ValidTo         string `json:"validTo"`
+}
+
+// DisruptedPoint represents Tfl.Api.Presentation.Entities.DisruptedPoint
+type DisruptedPoint struct {
+	AtcoCode              string `json:"atcoCode"`
+	FromDate              string `json:"fromDate"`
+	ToDate                string `json:"toDate"`
+	Description           string `json:"description"`
+	CommonName            string `json:"commonName"`
+	Type                  string `json:"type"`
+	Mode                  string `json:"mode"`
+	StationAtcoCode       string `json:"stationAtcoCode"`
+	Appearance            string `json:"appearance"`
+	AdditionalInformation string `json:"additionalInformation"`
+}
+
+// Line represents Tfl.Api.Presentation.Entities.Line
+type Line struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	ModeName     string       `json:"modeName"`
+	Disruptions  []Disruption `json:"disruptions"`
+	Created      string       `json:"created"`
+	Modified     string       `json:"modified"`
+	LineStatuses []LineStatus `json:"lineStatuses"`
+}
+
+// LineStatus represents Tfl.Api.Presentation.Entities.LineStatus
+type LineStatus struct {
+	ID                        int              `json:"id"`
+	LineID                    string           `json:"lineId"`
+	StatusSeverity            int              `json:"statusSeverity"`
+	StatusSeverityDescription string           `json:"statusSeverityDescription"`
+	Reason                    string           `json:"reason"`
+	Created                   string           `json:"created"`
+	ValidityPeriods           []ValidityPeriod `json:"validityPeriods"`
+	Disruption                *Disruption      `json:"disruption"`
+}
+
+// ValidityPeriod represents Tfl.Api.Presentation.Entities.ValidityPeriod
+type ValidityPeriod struct {
+	FromDate string `json:"fromDate"`
+	ToDate   string `json:"toDate"`
+	IsNow    bool   `json:"isNow"`
+}
+
+// Prediction represents Tfl.Api.Presentation.Entities.Prediction
+type Prediction struct {
+	ID                  string           `json:"id"`
+	OperationType       int              `json:"operationType"`
+	VehicleID           string           `json:"vehicleId"`
+	NaptanID            string           `json:"naptanId"`
+	StationName         string           `json:"stationName"`
+	LineID              string           `json:"lineId"`
+	LineName            string           `json:"lineName"`
+	PlatformName        string           `json:"platformName"`
+	Direction           string           `json:"direction"`
+	Bearing             string           `json:"bearing"`
+	DestinationNaptanID string           `json:"destinationNaptanId"`
+	DestinationName     string           `json:"destinationName"`
+	Timestamp           string           `json:"timestamp"`
+	TimeToStation       int              `json:"timeToStation"`
+	CurrentLocation     string           `json:"currentLocation"`
+	Towards             string           `json:"towards"`
+	ExpectedArrival     string           `json:"expectedArrival"`
+	TimeToLive          string           `json:"timeToLive"`
+	ModeName            string           `json:"modeName"`
+	Timing              PredictionTiming `json:"timing"`
+}
+
+// PredictionTiming represents Tfl.Api.Presentation.Entities.PredictionTiming
+type PredictionTiming struct {
+	CountdownServerAdjustment string `json:"countdownServerAdjustment"`
+	Source                    string `json:"source"`
+	Insert                    string `json:"insert"`
+	Read                      string `json:"read"`
+	Sent                      string `json:"sent"`
+	Received                  string `json:"received"`
+}
+
+// Place represents Tfl.Api.Presentation.Entities.Place
+type Place struct {
+	ID                   string                 `json:"id"`
+	URL                  string                 `json:"url"`
+	CommonName           string                 `json:"commonName"`
+	Distance             float64                `json:"distance"`
+	PlaceType            string                 `json:"placeType"`
+	AdditionalProperties []AdditionalProperties `json:"additionalProperties"`
+	Children             []Place                `json:"children"`
+	ChildrenURLs         []string               `json:"childrenUrls"`
+	Lat                  float64                `json:"lat"`
+	Lon                  float64                `json:"lon"`
+}
+
+// CarParkOccupancy represents Tfl.Api.Presentation.Entities.CarParkOccupancy
+type CarParkOccupancy struct {
+	ID                string `json:"id"`
+	Bays              []Bay  `json:"bays"`
+	Name              string `json:"name"`
+	CarParkDetailsURL string `json:"carParkDetailsUrl"`
+}
+
+// Bay represents Tfl.Api.Presentation.Entities.Bay
+type Bay struct {
+	BayType  string `json:"bayType"`
+	BayCount int    `json:"bayCount"`
+	Free     int    `json:"free"`
+	Occupied int    `json:"occupied"`
+}
+
+// ChargeConnectorOccupancy represents Tfl.Api.Presentation.Entities.ChargeConnectorOccupancy
+type ChargeConnectorOccupancy struct {
+	ID                  int    `json:"id"`
+	SourceSystemPlaceID string `json:"sourceSystemPlaceId"`
+	Status              string `json:"status"`
+}
+
+// BikePointOccupancy represents Tfl.Api.Presentation.Entities.BikePointOccupancy
+type BikePointOccupancy struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	BikesCount         int    `json:"bikesCount"`
+	EmptyDocks         int    `json:"emptyDocks"`
+	TotalDocks         int    `json:"totalDocks"`
+	StandardBikesCount int    `json:"standardBikesCount"`
+	EBikesCount        int    `json:"eBikesCount"`
+}
+
+// AirQuality represents the London air quality forecast returned by /AirQuality
+type AirQuality struct {
+	UpdatePeriod    string               `json:"updatePeriod"`
+	UpdateFrequency string               `json:"updateFrequency"`
+	ForecastURL     string               `json:"forecastURL"`
+	DisclaimerText  string               `json:"disclaimerText"`
+	CurrentForecast []AirQualityForecast `json:"currentForecast"`
+}
+
+// AirQualityForecast represents a current or future air quality forecast with a band per pollutant
+type AirQualityForecast struct {
+	ForecastType    string         `json:"forecastType"`
+	ForecastID      string         `json:"forecastID"`
+	ForecastBand    AirQualityBand `json:"forecastBand"`
+	ForecastSummary string         `json:"forecastSummary"`
+	NO2Band         AirQualityBand `json:"nO2Band"`
+	O3Band          AirQualityBand `json:"o3Band"`
+	PM10Band        AirQualityBand `json:"pM10Band"`
+	PM25Band        AirQualityBand `json:"pM25Band"`
+	SO2Band         AirQualityBand `json:"sO2Band"`
+	PublishedDate   string         `json:"publishedDate"`
+	FromDate        string         `json:"fromDate"`
+	ToDate          string         `json:"toDate"`
+	ForecastText    string         `json:"forecastText"`
+	ForecastHTML    string         `json:"-"`
+}
+
+// AccidentDetail represents Tfl.Api.Presentation.Entities.AccidentStats.AccidentDetail
+type AccidentDetail struct {
+	ID         int                `json:"id"`
+	Lat        float64            `json:"lat"`
+	Lon        float64            `json:"lon"`
+	Location   string             `json:"location"`
+	Date       string             `json:"date"`
+	Severity   AccidentSeverity   `json:"severity"`
+	Borough    string             `json:"borough"`
+	Casualties []AccidentCasualty `json:"casualties"`
+	Vehicles   []AccidentVehicle  `json:"vehicles"`
+}
+
+// AccidentCasualty represents Tfl.Api.Presentation.Entities.AccidentStats.Casualty
+type AccidentCasualty struct {
+	Age      int              `json:"age"`
+	Class    string           `json:"class"`
+	Severity AccidentSeverity `json:"severity"`
+	Mode     string           `json:"mode"`
+	AgeBand  string           `json:"ageBand"`
+}
+
+// AccidentVehicle represents Tfl.Api.Presentation.Entities.AccidentStats.Vehicle
+type AccidentVehicle struct {
+	Type string `json:"type"`
+}
@@ -0,0 +1,182 @@
+package tfl
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// VehiclePosition is the estimated position of a vehicle between the stop it last left and the next stop
+type VehiclePosition struct {
+	VehicleID       string
+	LineID          string
+	Timestamp       time.Time
+	PreviousStopID  string
+	NextStopID      string
+	NextStopName    string
+	TimeToNextStop  int
+	Progress        float64
+	Coordinate      *Coordinate
+	CurrentLocation string
+}
+
+// VehicleTracker combines successive arrivals of a vehicle into an estimate of its position
+// The line's route sequence is used to find the previous stop and its location where available
+type VehicleTracker struct {
+	client      *TflClient
+	vehicleID   string
+	sequences   map[string]*RouteSequence
+	nextStopID  string
+	previousID  string
+	segmentTime int
+}
+
+// GetVehicleArrivals retrieves the predicted arrivals of vehicles at their upcoming stops
+// It queries the endpoint /Vehicle/{ids}/Arrivals
+func (c *TflClient) GetVehicleArrivals(vehicleIDs []string) (*[]Prediction, error) {
+
+	pathParams := []string{vehiclePath, strings.Join(vehicleIDs, ","), arrivalsPath}
+	url := c.buildURL(pathParams)
+
+	resp := []Prediction{}
+	if err := c.getJSON(url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// NewVehicleTracker returns a VehicleTracker for the vehicle
+func (c *TflClient) NewVehicleTracker(vehicleID string) *VehicleTracker {
+	return &VehicleTracker{
+		client:    c,
+		vehicleID: vehicleID,
+		sequences: map[string]*RouteSequence{},
+	}
+}
+
+// Update retrieves the vehicle's arrivals and returns its estimated position
+func (t *VehicleTracker) Update() (*VehiclePosition, error) {
+	predictions, err := t.client.GetVehicleArrivals([]string{t.vehicleID})
+	if err != nil {
+		return nil, err
+	}
+	return t.Position(*predictions)
+}
+
+// Position estimates the vehicle's position from its predicted arrivals
+// Progress towards the next stop is measured against the longest time to it seen since the vehicle left the previous stop
+func (t *VehicleTracker) Position(predictions []Prediction) (*VehiclePosition, error) {
+
+	upcoming := []Prediction{}
+	for _, prediction := range predictions {
+		if prediction.VehicleID == t.vehicleID {
+			upcoming = append(upcoming, prediction)
+		}
+	}
+	if len(upcoming) == 0 {
+		return nil, errors.New("no arrivals predicted for vehicle " + t.vehicleID)
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].TimeToStation < upcoming[j].TimeToStation
+	})
+
+	next := upcoming[0]
+	timestamp, err := parseTflTime(next.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	if next.NaptanID != t.nextStopID {
+		if t.nextStopID != "" {
+			t.previousID = t.nextStopID
+		}
+		t.nextStopID = next.NaptanID
+		t.segmentTime = 0
+	}
+	if next.TimeToStation > t.segmentTime {
+		t.segmentTime = next.TimeToStation
+	}
+
+	position := &VehiclePosition{
+		VehicleID:       t.vehicleID,
+		LineID:          next.LineID,
+		Timestamp:       timestamp,
+		PreviousStopID:  t.previousID,
+		NextStopID:      next.NaptanID,
+		NextStopName:    next.StationName,
+		TimeToNextStop:  next.TimeToStation,
+		CurrentLocation: next.CurrentLocation,
+	}
+	if t.segmentTime > 0 {
+		position.Progress = 1 - float64(next.TimeToStation)/float64(t.segmentTime)
+	}
+
+	sequence := t.routeSequence(next.LineID)
+	if sequence == nil {
+		return position, nil
+	}
+
+	following := ""
+	if len(upcoming) > 1 {
+		following = upcoming[1].NaptanID
+	}
+	if previous, ok := sequence.previousStop(next.NaptanID, following); ok {
+		position.PreviousStopID = previous
+	}
+
+	stops := sequence.stopsByID()
+	from, fromOK := stops[position.PreviousStopID]
+	to, toOK := stops[position.NextStopID]
+	if fromOK && toOK {
+		position.Coordinate = &Coordinate{
+			Lat: from.Lat + (to.Lat-from.Lat)*position.Progress,
+			Lon: from.Lon + (to.Lon-from.Lon)*position.Progress,
+		}
+	}
+
+	return position, nil
+}
+
+// routeSequence returns the cached route sequence of a line, or nil if it is not available
+// Only retrieved sequences are cached so a failed lookup is retried on the next update
+func (t *VehicleTracker) routeSequence(lineID string) *RouteSequence {
+	if lineID == "" {
+		return nil
+	}
+	if sequence, ok := t.sequences[lineID]; ok {
+		return sequence
+	}
+	sequence, err := t.client.GetLineRouteSequence(lineID, "all")
+	if err != nil {
+		return nil
+	}
+	t.sequences[lineID] = sequence
+	return sequence
+}
+
+// previousStop returns the stop before next on a route which continues to following, if it is known
+func (r RouteSequence) previousStop(next, following string) (string, bool) {
+	for _, route := range r.OrderedLineRoutes {
+		for i, naptanID := range route.NaptanIDs {
+			if naptanID != next || i == 0 {
+				continue
+			}
+			if following != "" && !containsAfter(route.NaptanIDs, i, following) {
+				continue
+			}
+			return route.NaptanIDs[i-1], true
+		}
+	}
+	return "", false
+}
+
+func containsAfter(naptanIDs []string, index int, naptanID string) bool {
+	for _, id := range naptanIDs[index+1:] {
+		if id == naptanID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package tfl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTflClient_GetVehicleArrivals(t *testing.T) {
+
+	expected := []Prediction{}
+	json.Unmarshal(getTestDataFileContents("vehicle_arrivals.json"), &expected)
+
+	got, err := client.GetVehicleArrivals([]string{"237"})
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, got)
+}
+
+func TestVehicleTracker(t *testing.T) {
+
+	tracker := client.NewVehicleTracker("237")
+
+	position, err := tracker.Update()
+	assert.NoError(t, err)
+	assert.Equal(t, "940GZZLUVXL", position.PreviousStopID, "previous stop should come from the route sequence")
+	assert.Equal(t, "940GZZLUPCO", position.NextStopID)
+	assert.Equal(t, 120, position.TimeToNextStop)
+	assert.Equal(t, 0.0, position.Progress)
+	assert.Equal(t, &Coordinate{Lat: 51.485743, Lon: -0.124204}, position.Coordinate)
+
+	predictions := []Prediction{
+		{VehicleID: "237", LineID: "victoria", NaptanID: "940GZZLUPCO", TimeToStation: 60, Timestamp: "2020-08-22T16:11:00Z"},
+		{VehicleID: "237", LineID: "victoria", NaptanID: "940GZZLUVIC", TimeToStation: 180, Timestamp: "2020-08-22T16:11:00Z"},
+		{VehicleID: "999", LineID: "victoria", NaptanID: "940GZZLUGPK", TimeToStation: 10, Timestamp: "2020-08-22T16:11:00Z"},
+	}
+	position, err = tracker.Position(predictions)
+	assert.NoError(t, err)
+	assert.Equal(t, "940GZZLUPCO", position.NextStopID)
+	assert.Equal(t, 0.5, position.Progress)
+	assert.InDelta(t, (51.485743+51.489097)/2, position.Coordinate.Lat, 0.000001)
+	assert.InDelta(t, (-0.124204+-0.133761)/2, position.Coordinate.Lon, 0.000001)
+
+	_, err = tracker.Position(predictions[2:])
+	assert.EqualError(t, err, "no arrivals predicted for vehicle 237")
+}
+
+func TestVehicleTracker_withoutRouteSequence(t *testing.T) {
+
+	tracker := client.NewVehicleTracker("bus-1")
+	predictions := func(naptanID string, timeToStation int) []Prediction {
+		return []Prediction{{VehicleID: "bus-1", LineID: "unknown", NaptanID: naptanID, TimeToStation: timeToStation, Timestamp: "2020-08-22T16:11:00Z"}}
+	}
+
+	position, err := tracker.Position(predictions("490000001A", 90))
+	assert.NoError(t, err)
+	assert.Equal(t, "", position.PreviousStopID)
+	assert.Nil(t, position.Coordinate)
+
+	position, err = tracker.Position(predictions("490000002B", 100))
+	assert.NoError(t, err)
+	assert.Equal(t, "490000001A", position.PreviousStopID, "previous stop should come from the last next stop")
+	assert.Equal(t, "490000002B", position.NextStopID)
+}
+
+func TestVehicleTracker_retriesFailedRouteSequence(t *testing.T) {
+
+	sequenceRequests := 0
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/Line/victoria/Route/Sequence/") {
+			http.NotFound(w, r)
+			return
+		}
+		sequenceRequests++
+		if sequenceRequests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write(getTestDataFileContents("line_route_sequence.json"))
+	}))
+	defer stub.Close()
+	stubClient, _ := New(WithBaseURL(stub.URL))
+
+	tracker := stubClient.NewVehicleTracker("237")
+	predictions := []Prediction{
+		{VehicleID: "237", LineID: "victoria", NaptanID: "940GZZLUPCO", TimeToStation: 120, Timestamp: "2020-08-22T16:10:00Z"},
+	}
+
+	position, err := tracker.Position(predictions)
+	assert.NoError(t, err)
+	assert.Nil(t, position.Coordinate, "no coordinate without a route sequence")
+
+	position, err = tracker.Position(predictions)
+	assert.NoError(t, err)
+	assert.Equal(t, "940GZZLUVXL", position.PreviousStopID, "route sequence should be retried after a failure")
+	assert.NotNil(t, position.Coordinate)
+
+	tracker.Position(predictions)
+	assert.Equal(t, 2, sequenceRequests, "retrieved route sequence should be cached")
+}